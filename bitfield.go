@@ -5,12 +5,22 @@ import (
 	"fmt"
 )
 
+// ErrSizeTooLarge is returned when the size of an operation is greater than the 64 bits of a uint64 value.
+var ErrSizeTooLarge = errors.New("size is larger than 64 bits")
+
 // BitField represents a field of bits and provides methods for manipulating bits.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField instead, which supports both LittleEndian and BigEndian bit numbering.
 type BitField struct {
 	bits []byte // The underlying storage for the bit field, as a slice of bytes.
 	sz   uint   // The size of the bit field in bits.
 }
 
+// BitManipulator is the interface for setting, clearing, toggling and testing individual bits,
+// which BitField implements.
+//
+// Deprecated: Use the SetBit, ClearBit, ToggleBit and TestBit methods of go.loafoe.dev/bitfield/v2.BitField
+// instead. The v2 BitManipulator interface is unrelated: it selects the bit numbering of a v2 BitField.
 type BitManipulator interface {
 	SetBit(pos uint) error
 	ClearBit(pos uint) error
@@ -32,12 +42,34 @@ func New(n uint) *BitField {
 
 // Bytes creates a copy of the underlying bits slice.
 // Returns the newly created copy
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.Bytes instead.
 func (bf *BitField) Bytes() []byte {
 	copiedBytes := make([]byte, len(bf.bits))
 	copy(copiedBytes, bf.bits)
 	return copiedBytes
 }
 
+// Size returns the size of the BitField in number of bits.
+func (bf *BitField) Size() uint {
+	return bf.sz
+}
+
+// Grow increases the size of the BitField by the specified number of bits.
+// The underlying bits slice is only extended when the new size needs more bytes,
+// and the existing bits are preserved. The newly added bits read as 0.
+func (bf *BitField) Grow(additional uint) {
+	// Clear the unused bits of the final byte, as they become part of the BitField.
+	if r := bf.sz % 8; r != 0 {
+		bf.bits[bf.sz/8] &^= 0xFF << r
+	}
+
+	bf.sz += additional
+	if n := int((bf.sz + 7) / 8); n > len(bf.bits) {
+		bf.bits = append(bf.bits, make([]byte, n-len(bf.bits))...)
+	}
+}
+
 // Deprecated: Use go.loafoe.dev/bitfield/v2.LittleEndian.FromBytes instead.
 func FromBytes(bytes []byte) *BitField {
 	bf := New(uint(len(bytes) * 8))
@@ -64,6 +96,8 @@ func (bf *BitField) calculateBitPosition(pos uint) (byteIndex int, bitPosition u
 // Parameters:
 // - pos uint: The position of the bit to set, in bits (0-based index).
 // Returns an error if the position is out of the range of the BitField.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.SetBit instead.
 func (bf *BitField) SetBit(pos uint) error {
 	byteIndex, bitPosition, err := bf.calculateBitPosition(pos)
 	if err != nil {
@@ -77,6 +111,8 @@ func (bf *BitField) SetBit(pos uint) error {
 // Parameters:
 // - pos uint: The position of the bit to clear, in bits (0-based index).
 // Returns an error if the position is out of the range of the BitField.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.ClearBit instead.
 func (bf *BitField) ClearBit(pos uint) error {
 	byteIndex, bitPosition, err := bf.calculateBitPosition(pos)
 	if err != nil {
@@ -90,6 +126,8 @@ func (bf *BitField) ClearBit(pos uint) error {
 // Parameters:
 // - pos uint: The position of the bit to toggle, in bits (0-based index).
 // Returns an error if the position is out of the range of the BitField.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.ToggleBit instead.
 func (bf *BitField) ToggleBit(pos uint) error {
 	byteIndex, bitPosition, err := bf.calculateBitPosition(pos)
 	if err != nil {
@@ -103,6 +141,8 @@ func (bf *BitField) ToggleBit(pos uint) error {
 // Parameters:
 // - pos uint: The position of the bit to retrieve, in bits (0-based index).
 // Returns the value of the bit (true for 1, false for 0) and an error if the position is out of range.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.TestBit instead.
 func (bf *BitField) TestBit(pos uint) (bool, error) {
 	byteIndex, bitPosition, err := bf.calculateBitPosition(pos)
 	if err != nil {
@@ -119,11 +159,18 @@ func (bf *BitField) TestBit(pos uint) (bool, error) {
 // - offset uint: The starting position for setting the bits, in bits (0-based index).
 // - size uint: The number of bits to set.
 // - value uint64: The value to set, interpreted as LSB-first. Only the lowest 'size' bits are used.
-// Returns an error if the operation goes beyond the bounds of the BitField or if size is invalid.
+// Returns an error if the operation goes beyond the bounds of the BitField or ErrSizeTooLarge if size is greater than 64.
+// A zero size writes nothing and succeeds for any offset up to the size of the BitField.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.InsertUint64 instead, which uses the manipulator of the BitField.
 func (bf *BitField) InsertUint(man BitManipulator, offset, size uint, value uint64) error {
-	// Validate that the operation is within bounds
-	if offset+size > bf.sz || size > 64 {
-		return errors.New("operation out of bounds or size is invalid")
+	// Validate that the value fits in a uint64 and that the operation is within bounds,
+	// without computing offset+size as it may overflow
+	if size > 64 {
+		return ErrSizeTooLarge
+	}
+	if offset > bf.sz || size > bf.sz-offset {
+		return errors.New("operation out of bounds")
 	}
 
 	for i := uint(0); i < size; i++ {
@@ -152,10 +199,16 @@ func (bf *BitField) InsertUint(man BitManipulator, offset, size uint, value uint
 // Parameters:
 // - offset uint: The starting position for retrieving the bits, in bits (0-based index).
 // - size uint: The number of bits to retrieve.
-// Returns the retrieved bits as a uint64 value and an error if the operation goes beyond the bounds of the BitField.
+// Returns the retrieved bits as a uint64 value and an error if the operation goes beyond the bounds of the BitField
+// or if size is greater than 64.
+//
+// Deprecated: Use go.loafoe.dev/bitfield/v2.BitField.ExtractUint64 instead, which uses the manipulator of the BitField.
 func (bf *BitField) ExtractUint(man BitManipulator, offset, size uint) (uint64, error) {
-	if offset+size > bf.sz {
-		return 0, fmt.Errorf("range [%d, %d] out of bounds", offset, offset+size)
+	if size > 64 {
+		return 0, ErrSizeTooLarge
+	}
+	if offset > bf.sz || size > bf.sz-offset {
+		return 0, fmt.Errorf("range of %d bits at offset %d out of bounds", size, offset)
 	}
 
 	var group uint64
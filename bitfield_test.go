@@ -24,6 +24,14 @@ type FromBytesTestCase struct {
 	expectedLen uint   // Expected length of the underlying byte slice
 }
 
+type GrowTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	additional   uint      // Number of bits to grow the BitField by
+	expectedSize uint      // Expected size after growing
+	expectedBits []byte    // Expected byte slice after growing
+}
+
 type SetBitTestCase struct {
 	name         string    // Name of the test case
 	bf           *BitField // Initial BitField for the test
@@ -152,6 +160,56 @@ var fromBytesTestCases = []FromBytesTestCase{
 	},
 }
 
+var growTestCases = []GrowTestCase{
+	{
+		name: "Grow from 5 to 20 bits",
+		bf: &BitField{
+			bits: []byte{0b00010101},
+			sz:   5,
+		},
+		additional:   15,
+		expectedSize: 20,
+		expectedBits: []byte{0b00010101, 0b00000000, 0b00000000},
+	},
+	{
+		name: "Grow within the final byte",
+		bf: &BitField{
+			bits: []byte{0b00000111},
+			sz:   3,
+		},
+		additional:   4,
+		expectedSize: 7,
+		expectedBits: []byte{0b00000111},
+	},
+	{
+		name: "Unused bits are cleared",
+		bf: &BitField{
+			bits: []byte{0b11111111},
+			sz:   4,
+		},
+		additional:   8,
+		expectedSize: 12,
+		expectedBits: []byte{0b00001111, 0b00000000},
+	},
+	{
+		name:         "Grow empty BitField",
+		bf:           New(0),
+		additional:   9,
+		expectedSize: 9,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Grow by zero bits",
+		bf: &BitField{
+			bits: []byte{0b10101010},
+			sz:   8,
+		},
+		additional:   0,
+		expectedSize: 8,
+		expectedBits: []byte{0b10101010},
+	},
+}
+
 var setBitTestCases = []SetBitTestCase{
 	{
 		name: "Set first bit to true in 2-byte field",
@@ -306,6 +364,28 @@ var insertUintTestCases = []InsertUintTestCase{
 		value:        0b1,
 		expectedBits: []byte{0b00000000, 0b00000000},
 	},
+	{
+		name: "Insert zero size at end",
+		bf: &BitField{
+			bits: []byte{0b00000000, 0b00000000},
+			sz:   16,
+		},
+		offset:       16,
+		size:         0,
+		value:        0b1,
+		expectedBits: []byte{0b00000000, 0b00000000}, // Nothing is written at offset == size
+	},
+	{
+		name: "Insert zero size beyond end",
+		bf: &BitField{
+			bits: []byte{0b00000000, 0b00000000},
+			sz:   16,
+		},
+		offset:      17,
+		size:        0,
+		value:       0b1,
+		expectError: true, // Only offsets up to the size are valid, even when nothing is written
+	},
 	{
 		name: "Insert at offset",
 		bf: &BitField{
@@ -328,6 +408,17 @@ var insertUintTestCases = []InsertUintTestCase{
 		value:       0b1111111111111111111111111111111111111111111111111111111111111111,
 		expectError: true,
 	},
+	{
+		name: "Insert with offset near the maximum",
+		bf: &BitField{
+			bits: []byte{0b00000000, 0b00000000},
+			sz:   16,
+		},
+		offset:      ^uint(0) - 3, // offset+size wraps around to a small value
+		size:        8,
+		value:       0b11111111,
+		expectError: true,
+	},
 	{
 		name: "Insert spanning multiple bytes",
 		bf: &BitField{
@@ -374,6 +465,26 @@ var insertUintTestCases = []InsertUintTestCase{
 }
 
 var extractUintTestCases = []ExtractUintTestCase{
+	{
+		name: "Extract with offset near the maximum",
+		bf: &BitField{
+			bits: []byte{0b00000000, 0b00000000},
+			sz:   16,
+		},
+		offset:      ^uint(0) - 3, // offset+size wraps around to a small value
+		size:        8,
+		expectError: true,
+	},
+	{
+		name: "Invalid size greater than 64",
+		bf: &BitField{
+			bits: make([]byte, 16),
+			sz:   128,
+		},
+		offset:      0,
+		size:        65,
+		expectError: true,
+	},
 	{
 		name: "Extract within range",
 		bf: &BitField{
@@ -455,6 +566,17 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestSize(t *testing.T) {
+	for _, tc := range newTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bf := New(tc.n)
+			if bf.Size() != tc.n {
+				t.Errorf("Size() got %d, want %d", bf.Size(), tc.n)
+			}
+		})
+	}
+}
+
 func TestBytes(t *testing.T) {
 	for _, tc := range bytesTestCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -481,6 +603,20 @@ func TestFromBytes(t *testing.T) {
 	}
 }
 
+func TestGrow(t *testing.T) {
+	for _, tc := range growTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.bf.Grow(tc.additional)
+			if tc.bf.sz != tc.expectedSize {
+				t.Errorf("Grow() got size %d, want %d", tc.bf.sz, tc.expectedSize)
+			}
+			if !reflect.DeepEqual(tc.bf.bits, tc.expectedBits) {
+				t.Errorf("Grow() got %v, want %v", tc.bf.bits, tc.expectedBits)
+			}
+		})
+	}
+}
+
 func TestSetBit(t *testing.T) {
 	for _, tc := range setBitTestCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -601,3 +737,19 @@ func TestExtractUint(t *testing.T) {
 		})
 	}
 }
+
+func TestSizeTooLarge(t *testing.T) {
+	bf := New(128)
+
+	if err := bf.InsertUint(bf, 0, 65, 0); !errors.Is(err, ErrSizeTooLarge) {
+		t.Errorf("InsertUint() got error %v, want %v", err, ErrSizeTooLarge)
+	}
+	if _, err := bf.ExtractUint(bf, 0, 65); !errors.Is(err, ErrSizeTooLarge) {
+		t.Errorf("ExtractUint() got error %v, want %v", err, ErrSizeTooLarge)
+	}
+
+	// Out of bounds sizes that fit in a uint64 are a different error
+	if err := bf.InsertUint(bf, 100, 64, 0); err == nil || errors.Is(err, ErrSizeTooLarge) {
+		t.Errorf("InsertUint() got error %v, want an out of bounds error", err)
+	}
+}
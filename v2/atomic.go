@@ -0,0 +1,62 @@
+package bitfield
+
+import (
+	"fmt"
+	"sync/atomic"
+	"unsafe"
+)
+
+// byteWord returns the aligned 32-bit word that contains the byte at index i of the underlying data,
+// together with the index of that byte within the word. As the data is allocated by allocData, the word
+// lies entirely within the backing array of the BitField.
+func (bf *BitField) byteWord(i uint64) (word *uint32, k uintptr) {
+	p := unsafe.Pointer(&bf.data[i])
+	k = uintptr(p) & 3
+	return (*uint32)(unsafe.Add(p, -int(k))), k
+}
+
+// updateBitAtomic atomically replaces the byte holding pos with the result of applying update to it
+// and the mask of pos. The update is retried until no other atomic operation has changed the word in between.
+func (bf *BitField) updateBitAtomic(pos uint64, update func(b, mask byte) byte) error {
+	if pos >= bf.size {
+		return fmt.Errorf("%w: position %d, size %d", ErrOutOfRange, pos, bf.size)
+	}
+
+	word, k := bf.byteWord(pos / 8)
+	mask := bf.posMask(pos%8, pos%8+1)
+	for {
+		old := atomic.LoadUint32(word)
+		b := *(*[4]byte)(unsafe.Pointer(&old))
+		b[k] = update(b[k], mask)
+		if atomic.CompareAndSwapUint32(word, old, *(*uint32)(unsafe.Pointer(&b))) {
+			return nil
+		}
+	}
+}
+
+// SetBitAtomic atomically sets the bit at pos to 1.
+//
+// The atomic methods may be called concurrently with each other, but not concurrently with any
+// non-atomic method of the BitField. They neither consult nor set the sticky error.
+func (bf *BitField) SetBitAtomic(pos uint64) error {
+	return bf.updateBitAtomic(pos, func(b, mask byte) byte { return b | mask })
+}
+
+// ClearBitAtomic atomically sets the bit at pos to 0.
+// The same restrictions as for SetBitAtomic apply.
+func (bf *BitField) ClearBitAtomic(pos uint64) error {
+	return bf.updateBitAtomic(pos, func(b, mask byte) byte { return b &^ mask })
+}
+
+// TestBitAtomic atomically reports whether the bit at pos is set.
+// The same restrictions as for SetBitAtomic apply.
+func (bf *BitField) TestBitAtomic(pos uint64) (bool, error) {
+	if pos >= bf.size {
+		return false, fmt.Errorf("%w: position %d, size %d", ErrOutOfRange, pos, bf.size)
+	}
+
+	word, k := bf.byteWord(pos / 8)
+	value := atomic.LoadUint32(word)
+	b := *(*[4]byte)(unsafe.Pointer(&value))
+	return b[k]&bf.posMask(pos%8, pos%8+1) != 0, nil
+}
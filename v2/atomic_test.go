@@ -0,0 +1,114 @@
+package bitfield
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+// Test functions
+
+func TestAtomicBits(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		const size = 64
+		bf := m.New(size)
+
+		// Each goroutine owns one bit, so neighbouring goroutines share bytes and words.
+		var wg sync.WaitGroup
+		for pos := uint64(0); pos < size; pos++ {
+			wg.Add(1)
+			go func(pos uint64) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					bf.SetBitAtomic(pos)
+					bf.ClearBitAtomic(pos)
+				}
+				bf.SetBitAtomic(pos)
+			}(pos)
+		}
+		wg.Wait()
+
+		for pos := uint64(0); pos < size; pos++ {
+			if set, err := bf.TestBitAtomic(pos); err != nil || !set {
+				t.Errorf("TestBitAtomic(%d) got %v, %v, want true, nil", pos, set, err)
+			}
+		}
+		if got := bf.OnesCount(); got != size {
+			t.Errorf("OnesCount() got %d, want %d", got, size)
+		}
+	}
+}
+
+func TestAtomicBitsOverlapping(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(13)
+
+		// All goroutines set the same bits, mixed with tests of the other bits in those bytes.
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					bf.SetBitAtomic(3)
+					bf.SetBitAtomic(12)
+					bf.TestBitAtomic(4)
+					bf.TestBitAtomic(11)
+				}
+			}()
+		}
+		wg.Wait()
+
+		expected := m.New(13)
+		expected.SetBit(3)
+		expected.SetBit(12)
+		if !bf.Equal(expected) {
+			t.Errorf("got %v, want %v", bf, expected)
+		}
+	}
+}
+
+func TestAtomicOutOfRange(t *testing.T) {
+	bf := LittleEndian.New(10)
+
+	if err := bf.SetBitAtomic(10); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("SetBitAtomic() got error %v, want %v", err, ErrOutOfRange)
+	}
+	if err := bf.ClearBitAtomic(10); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ClearBitAtomic() got error %v, want %v", err, ErrOutOfRange)
+	}
+	if _, err := bf.TestBitAtomic(10); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("TestBitAtomic() got error %v, want %v", err, ErrOutOfRange)
+	}
+	if bf.Error() != nil {
+		t.Errorf("Error() got %v, want nil", bf.Error())
+	}
+}
+
+func TestAtomicDataWordAligned(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for size := uint64(1); size <= 72; size++ {
+			bf := m.New(size)
+			grown := m.FromBytes([]byte{0xFF}, size)
+			grown.Resize(size * 3)
+			var decoded BitField
+			encoded, _ := bf.MarshalBinary()
+			decoded.UnmarshalBinary(encoded)
+
+			for name, f := range map[string]*BitField{
+				"New":             bf,
+				"FromBytes":       m.FromBytes([]byte{0xAA, 0x55}, size),
+				"NewWithCapacity": NewWithCapacity(size, size*2, m),
+				"Clone":           bf.Clone(),
+				"Resize":          grown,
+				"UnmarshalBinary": &decoded,
+			} {
+				data := f.data[:cap(f.data)]
+				if uintptr(unsafe.Pointer(&data[0]))%4 != 0 || cap(data)%4 != 0 {
+					t.Errorf("%s(%d) got data at %p with capacity %d, want whole aligned 32-bit words", name, size, &data[0], cap(data))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package bitfield
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// valuePos returns the position of bit j, counting from the least significant bit, of a size-bit value
+// stored at offset. This follows the value order of the manipulator's InsertUint64 and ExtractUint64.
+func (bf *BitField) valuePos(offset, size, j uint64) uint64 {
+	if bf.msb0 {
+		return offset + size - 1 - j
+	}
+	return offset + j
+}
+
+// FromBigInt creates a new BitField of v.BitLen() bits that uses the manipulator m and holds v,
+// in the same bit order as InsertUint64. Returns an error if v is negative.
+func FromBigInt(v *big.Int, m BitManipulator) (*BitField, error) {
+	bf := m.New(uint64(v.BitLen()))
+	if err := bf.insertBigInt(0, bf.size, v); err != nil {
+		return nil, err
+	}
+	return bf, nil
+}
+
+// InsertBigInt sets size bits starting at offset to the value v, in the same bit order as InsertUint64.
+// Returns an error if the operation goes beyond the bounds of the BitField, if v is negative,
+// or if v requires more than size bits.
+func (bf *BitField) InsertBigInt(offset, size uint64, v *big.Int) error {
+	if bf.err == nil {
+		bf.err = bf.insertBigInt(offset, size, v)
+	}
+	return bf.err
+}
+
+func (bf *BitField) insertBigInt(offset, size uint64, v *big.Int) error {
+	if err := bf.checkRange(offset, size); err != nil {
+		return err
+	}
+	if v.Sign() < 0 {
+		return fmt.Errorf("%w: %v is negative", ErrInvalidValue, v)
+	}
+	if uint64(v.BitLen()) > size {
+		return fmt.Errorf("%w: %d-bit value exceeds %d bits", ErrInvalidValue, v.BitLen(), size)
+	}
+
+	for j := uint64(0); j < size; j++ {
+		bf.putBit(bf.valuePos(offset, size, j), v.Bit(int(j)) == 1)
+	}
+	return nil
+}
+
+// ExtractBigInt retrieves size bits starting at offset as a non-negative big.Int,
+// in the same bit order as ExtractUint64.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) ExtractBigInt(offset, size uint64) (*big.Int, error) {
+	if bf.err != nil {
+		return nil, bf.err
+	}
+	if err := bf.checkRange(offset, size); err != nil {
+		return nil, err
+	}
+
+	v := new(big.Int)
+	for j := uint64(0); j < size; j++ {
+		if bf.bit(bf.valuePos(offset, size, j)) {
+			v.SetBit(v, int(j), 1)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,170 @@
+package bitfield
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// Test case structs
+
+type InsertBigIntTestCase struct {
+	name        string    // Name of the test case
+	bf          *BitField // Initial BitField for the test
+	offset      uint64    // The position to insert at
+	size        uint64    // The number of bits to insert
+	value       *big.Int  // The value to insert
+	expectError bool      // Whether an error is expected
+}
+
+// Test cases
+
+var value128, _ = new(big.Int).SetString("8f3a0000000000000000000000c0ffee", 16)
+
+var insertBigIntTestCases = []InsertBigIntTestCase{
+	{
+		name:   "128-bit value at non-aligned offset LE",
+		bf:     LittleEndian.New(140),
+		offset: 5,
+		size:   128,
+		value:  value128,
+	},
+	{
+		name:   "128-bit value at non-aligned offset BE",
+		bf:     BigEndian.New(140),
+		offset: 5,
+		size:   128,
+		value:  value128,
+	},
+	{
+		name:   "Zero value",
+		bf:     LittleEndian.New(80),
+		offset: 3,
+		size:   70,
+		value:  big.NewInt(0),
+	},
+	{
+		name:        "Value wider than size",
+		bf:          LittleEndian.New(140),
+		offset:      0,
+		size:        127,
+		value:       value128,
+		expectError: true,
+	},
+	{
+		name:        "Negative value",
+		bf:          BigEndian.New(16),
+		offset:      0,
+		size:        16,
+		value:       big.NewInt(-1),
+		expectError: true,
+	},
+	{
+		name:        "Insert beyond size",
+		bf:          BigEndian.New(128),
+		offset:      1,
+		size:        128,
+		value:       big.NewInt(1),
+		expectError: true,
+	},
+}
+
+// Test functions
+
+func TestInsertBigInt(t *testing.T) {
+	for _, tc := range insertBigIntTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bf.InsertBigInt(tc.offset, tc.size, tc.value)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("InsertBigInt() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if tc.expectError {
+				return
+			}
+
+			value, err := tc.bf.ExtractBigInt(tc.offset, tc.size)
+			if err != nil {
+				t.Errorf("ExtractBigInt() returned unexpected error: %v", err)
+				return
+			}
+			if value.Cmp(tc.value) != 0 {
+				t.Errorf("ExtractBigInt() got %x, want %x", value, tc.value)
+			}
+			if tc.bf.OnesCount() != uint64(popCount(tc.value)) {
+				t.Errorf("InsertBigInt() wrote bits outside of the range")
+			}
+		})
+	}
+}
+
+func TestBigIntMatchesUint64Order(t *testing.T) {
+	low := new(big.Int).SetUint64(0x0123456789abcdef)
+	high := new(big.Int).SetUint64(0xfedcba9876543210)
+	v := new(big.Int).Or(new(big.Int).Lsh(high, 64), low)
+
+	// LittleEndian stores the least significant bits first, BigEndian the most significant bits first
+	expectedFirstWord := map[BitManipulator]uint64{
+		LittleEndian: low.Uint64(),
+		BigEndian:    high.Uint64(),
+	}
+	for m, expected := range expectedFirstWord {
+		bf := m.New(140)
+		bf.InsertBigInt(7, 128, v)
+
+		if value, _ := bf.ExtractUint64(7, 64); value != expected {
+			t.Errorf("ExtractUint64() got %x, want %x", value, expected)
+		}
+	}
+}
+
+func TestFromBigInt(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for _, v := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(0b101101), value128} {
+			bf, err := FromBigInt(v, m)
+			if err != nil {
+				t.Fatalf("FromBigInt() returned unexpected error: %v", err)
+			}
+			if bf.Size() != uint64(v.BitLen()) || bf.manipulator != m {
+				t.Errorf("FromBigInt() got size %d and manipulator %v, want %d and %v", bf.Size(), bf.manipulator, v.BitLen(), m)
+			}
+
+			extracted, err := bf.ExtractBigInt(0, bf.Size())
+			if err != nil {
+				t.Fatalf("ExtractBigInt() returned unexpected error: %v", err)
+			}
+			if extracted.Cmp(v) != 0 {
+				t.Errorf("FromBigInt() round trip got %v, want %v", extracted, v)
+			}
+		}
+	}
+
+	// The most significant bit is at position 0 for BigEndian and at the last position for LittleEndian
+	be, _ := FromBigInt(big.NewInt(0b110), BigEndian)
+	le, _ := FromBigInt(big.NewInt(0b110), LittleEndian)
+	if be.String() != "110" || le.String() != "011" {
+		t.Errorf("FromBigInt() got %q and %q, want %q and %q", be, le, "110", "011")
+	}
+}
+
+func TestFromBigIntNegative(t *testing.T) {
+	if _, err := FromBigInt(big.NewInt(-5), LittleEndian); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("FromBigInt() got error %v, want %v", err, ErrInvalidValue)
+	}
+}
+
+func TestExtractBigIntOutOfRange(t *testing.T) {
+	if _, err := LittleEndian.New(64).ExtractBigInt(60, 5); err == nil {
+		t.Errorf("ExtractBigInt() beyond size expected an error, but got none")
+	}
+}
+
+func popCount(v *big.Int) int {
+	count := 0
+	for i := 0; i < v.BitLen(); i++ {
+		count += int(v.Bit(i))
+	}
+	return count
+}
@@ -1,10 +1,17 @@
 package bitfield
 
+import (
+	"fmt"
+	"math/bits"
+	"unsafe"
+)
+
 // BitField represents a field of bits. It provides methods for manipulating bits within a byte slice.
 type BitField struct {
 	data        []byte         // The underlying byte slice that stores the bits.
 	size        uint64         // The size of the bit field in bits.
 	manipulator BitManipulator // An interface that provides methods for bit manipulation.
+	msb0        bool           // Whether the manipulator numbers in-byte position 0 as the most significant bit.
 	err         error          // An error that is set when a bit manipulation method fails.
 }
 
@@ -12,6 +19,11 @@ type BitField struct {
 // This includes setting, clearing, toggling, and testing individual bits, as well as
 // inserting and extracting multi-bit values.
 // BigEndian and LittleEndian are the included implementations of this interface.
+//
+// Other implementations are supported. The methods of BitField that access whole bytes directly assume
+// that an implementation numbers the bits within each byte like one of the included implementations.
+// Which one is determined once per BitField, by setting position 0 of a scratch BitField when the BitField
+// is created with the implementation; an implementation that numbers the bits otherwise causes a panic.
 type BitManipulator interface {
 	New(n uint64) *BitField
 	FromBytes(bytes []byte, size uint64) *BitField
@@ -23,6 +35,74 @@ type BitManipulator interface {
 	ExtractUint64(bf *BitField, offset, size uint64) (uint64, error)
 }
 
+// PhysicalPosition returns the byte index and the in-byte bit index (0 = least significant) holding
+// position pos under the manipulator m. The position is not checked against the size of any BitField.
+func PhysicalPosition(pos uint64, m BitManipulator) (bytePos, bitInByte uint64) {
+	if msb0(m) {
+		return pos / 8, 7 - pos%8
+	}
+	return pos / 8, pos % 8
+}
+
+// bitOrder is implemented by the included manipulators to report their bit numbering without probing.
+// Manipulators outside the package cannot implement it, as its method is unexported.
+type bitOrder interface {
+	msb0() bool
+}
+
+// msb0 reports whether m numbers in-byte position 0 as the most significant bit of a byte.
+// Manipulators that do not implement bitOrder are probed by setting position 0 of a scratch BitField,
+// so the result is cached in each BitField rather than computed per access.
+// It panics if the probe fails or sets a bit other than the first or last of the byte.
+func msb0(m BitManipulator) bool {
+	if o, ok := m.(bitOrder); ok {
+		return o.msb0()
+	}
+	probe := &BitField{data: allocData(1, 1), size: 8, manipulator: m}
+	if err := m.SetBit(probe, 0); err != nil {
+		panic(fmt.Sprintf("bitfield: probing the bit order of %T: %v", m, err))
+	}
+	switch probe.data[0] {
+	case 0x80:
+		return true
+	case 0x01:
+		return false
+	default:
+		panic(fmt.Sprintf("bitfield: %T sets byte %#02x for position 0, want 0x01 or 0x80", m, probe.data[0]))
+	}
+}
+
+// NewFromBytesChecked creates a new BitField of size bits that uses the manipulator m and holds a copy of bytes,
+// like FromBytes, but rejects input that does not match size exactly: it returns an error if bytes holds fewer
+// than size bits, holds bytes beyond those needed for size bits, or has any padding bit beyond size set.
+// This makes it suitable for untrusted input; use FromBytes and Canonicalize to accept such input instead.
+func NewFromBytesChecked(bytes []byte, size uint64, m BitManipulator) (*BitField, error) {
+	if size > uint64(len(bytes))*8 {
+		return nil, fmt.Errorf("%w: %d bytes hold fewer than %d bits", ErrInvalidSize, len(bytes), size)
+	}
+	if n := (size + 7) / 8; uint64(len(bytes)) > n {
+		return nil, fmt.Errorf("%w: %d bytes for %d bits, want %d", ErrInvalidSize, len(bytes), size, n)
+	}
+
+	bf := m.FromBytes(bytes, size)
+	if bf.HasDirtyPadding() {
+		return nil, fmt.Errorf("%w: padding bits beyond %d bits are set", ErrInvalidEncoding, size)
+	}
+	return bf, nil
+}
+
+// NewWithCapacity creates a new, cleared BitField of n bits that uses the manipulator m, like m.New(n), whose
+// underlying byte slice has room for at least capacityBits bits, rounded up to a power of two number of bytes.
+// Growing the BitField with Resize or Append up to that capacity does not reallocate.
+func NewWithCapacity(n, capacityBits uint64, m BitManipulator) *BitField {
+	return &BitField{
+		data:        makeData(n, capacityBits),
+		size:        n,
+		manipulator: m,
+		msb0:        msb0(m),
+	}
+}
+
 // Bytes returns a copy of the underlying data as a byte slice.
 func (bf *BitField) Bytes() []byte {
 	copiedBytes := make([]byte, len(bf.data))
@@ -30,16 +110,223 @@ func (bf *BitField) Bytes() []byte {
 	return copiedBytes
 }
 
+// RawBytes returns the underlying data of the BitField without copying it, for read-only use such as hashing.
+// The returned slice aliases the BitField: writes to it modify the BitField and later mutations of the BitField
+// are visible through it. It must not be retained across Resize, Append or Reset, which may reallocate the data.
+// The padding bits of the final byte are returned as stored; use CanonicalBytes for a copy with them cleared.
+func (bf *BitField) RawBytes() []byte {
+	return bf.data
+}
+
+// Clone returns a deep copy of the BitField with the same size and manipulator.
+// The sticky error of the BitField is not copied.
+func (bf *BitField) Clone() *BitField {
+	data := allocData(uint64(len(bf.data)), uint64(len(bf.data)))
+	copy(data, bf.data)
+
+	return &BitField{
+		data:        data,
+		size:        bf.size,
+		manipulator: bf.manipulator,
+		msb0:        bf.msb0,
+	}
+}
+
+// WithManipulator returns a new BitField holding the same bit at every position as the BitField,
+// with the underlying data laid out for the manipulator m. The padding bits of the result are cleared.
+func (bf *BitField) WithManipulator(m BitManipulator) *BitField {
+	result := m.New(bf.size)
+	copyBits(result, 0, bf, 0, bf.size)
+	return result
+}
+
+// byteCount returns the number of bytes needed to hold size bits. Unlike (size+7)/8, it does not wrap around
+// to 0 for sizes close to math.MaxUint64, so allocating that many bytes fails instead of yielding a BitField
+// whose data is shorter than its size.
+func byteCount(size uint64) uint64 {
+	return size/8 + (size%8+7)/8
+}
+
+// allocData returns a cleared byte slice of n bytes with a capacity of at least c bytes. Its backing array is
+// allocated as 32-bit words, so it is aligned to a word boundary and its capacity is a whole number of words.
+// All underlying data is allocated through allocData, which the atomic methods rely on to access it by word.
+func allocData(n, c uint64) []byte {
+	words := make([]uint32, (max(n, c)+3)/4)
+	if len(words) == 0 {
+		return []byte{}
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&words[0])), len(words)*4)[:n]
+}
+
+// sibling returns a new, cleared BitField of n bits that uses the same manipulator as the BitField.
+func (bf *BitField) sibling(n uint64) *BitField {
+	return &BitField{
+		data:        allocData(byteCount(n), byteCount(n)),
+		size:        n,
+		manipulator: bf.manipulator,
+		msb0:        bf.msb0,
+	}
+}
+
+// makeData returns a cleared byte slice covering n bits, whose capacity covers at least capacityBits bits
+// rounded up to a power of two number of bytes.
+func makeData(n, capacityBits uint64) []byte {
+	c := byteCount(max(n, capacityBits))
+	if c > 1 {
+		c = 1 << bits.Len64(c-1)
+	}
+	return allocData(byteCount(n), c)
+}
+
+// byteAligned reports whether the positions [offset, offset+size) cover whole bytes and bm is the
+// manipulator of the BitField itself. Only then may bm access those bytes directly, as a manipulator
+// that wraps bm may override the per-bit methods.
+func (bf *BitField) byteAligned(bm BitManipulator, offset, size uint64) bool {
+	return offset%8 == 0 && size%8 == 0 && bf.manipulator == bm
+}
+
+// saveRange copies the bytes covering the positions [offset, offset+count) and returns a function
+// that restores them, so that a failing multi-bit operation can leave the BitField unchanged.
+func (bf *BitField) saveRange(offset, count uint64) (restore func()) {
+	start, end := offset/8, (offset+count+7)/8
+	saved := make([]byte, end-start)
+	copy(saved, bf.data[start:end])
+	return func() {
+		copy(bf.data[start:end], saved)
+	}
+}
+
 // Size returns the size of the BitField in number of bits.
 func (bf *BitField) Size() uint64 {
 	return bf.size
 }
 
+// ByteLen returns the number of bytes needed to hold the bits of the BitField, which is the size rounded up to
+// a whole number of bytes. Unlike len(bf.Bytes()), it does not copy the underlying data.
+func (bf *BitField) ByteLen() int {
+	return int((bf.size + 7) / 8)
+}
+
+// Snapshot returns the size of the BitField and a copy of its underlying data with the padding bits cleared,
+// so that code outside the package can inspect and compare the content of a BitField.
+func (bf *BitField) Snapshot() (size uint64, data []byte) {
+	return bf.size, bf.canonical()
+}
+
+// Manipulator returns the BitManipulator used by the BitField.
+func (bf *BitField) Manipulator() BitManipulator {
+	return bf.manipulator
+}
+
 // Error returns the error set by the last failing bit manipulation method.
 func (bf *BitField) Error() error {
 	return bf.err
 }
 
+// ClearError clears the error set by the last failing bit manipulation method,
+// so that the BitField can be used again after the error has been handled.
+func (bf *BitField) ClearError() {
+	bf.err = nil
+}
+
+// Zero clears all bits of the BitField and its sticky error, so that it can be reused like a new BitField
+// of the same size. The size, manipulator and capacity of the underlying data are kept.
+func (bf *BitField) Zero() {
+	clear(bf.data)
+	bf.err = nil
+}
+
+// Reset reconfigures the BitField as a new, cleared BitField of size bits that uses the manipulator m,
+// and clears its sticky error. The underlying data is reused if its capacity suffices and reallocated otherwise,
+// which makes BitFields suitable for reuse through a sync.Pool.
+func (bf *BitField) Reset(size uint64, m BitManipulator) {
+	if n := byteCount(size); n > uint64(cap(bf.data)) {
+		bf.data = allocData(n, n)
+	} else {
+		bf.data = bf.data[:n]
+		clear(bf.data)
+	}
+	bf.size = size
+	bf.manipulator = m
+	bf.msb0 = msb0(m)
+	bf.err = nil
+}
+
+// normalize converts b between the manipulator's bit numbering and LSb 0 numbering,
+// so that bit i of the result holds in-byte position i. The conversion is its own inverse.
+func (bf *BitField) normalize(b byte) byte {
+	if bf.msb0 {
+		return bits.Reverse8(b)
+	}
+	return b
+}
+
+// posMask returns a mask of the bits holding the in-byte positions [from, to) of a byte,
+// where 0 <= from <= to <= 8.
+func (bf *BitField) posMask(from, to uint64) byte {
+	return bf.normalize((byte(0xFF) << from) & (byte(0xFF) >> (8 - to)))
+}
+
+// paddingMask returns a mask of the bits in the final byte that lie beyond the size of the BitField.
+func (bf *BitField) paddingMask() byte {
+	if r := bf.size % 8; r != 0 {
+		return bf.posMask(r, 8)
+	}
+	return 0
+}
+
+// clearPadding zeroes the bits in the final byte that lie beyond the size of the BitField.
+func (bf *BitField) clearPadding() {
+	if n := (bf.size + 7) / 8; n > 0 {
+		bf.data[n-1] &^= bf.paddingMask()
+	}
+}
+
+// paddingMaskAt returns the mask of padding bits in the byte at index i of the underlying data,
+// which is non-zero only for the final byte.
+func (bf *BitField) paddingMaskAt(i uint64) byte {
+	if i == (bf.size+7)/8-1 {
+		return bf.paddingMask()
+	}
+	return 0
+}
+
+// maskedByte returns the byte at index i of the underlying data with any padding bits cleared.
+func (bf *BitField) maskedByte(i uint64) byte {
+	return bf.data[i] &^ bf.paddingMaskAt(i)
+}
+
+// canonical returns a copy of the bytes covering the size of the BitField, with the padding bits cleared.
+func (bf *BitField) canonical() []byte {
+	data := make([]byte, (bf.size+7)/8)
+	for i := range data {
+		data[i] = bf.maskedByte(uint64(i))
+	}
+	return data
+}
+
+// bit returns the value of the bit at pos, bypassing the manipulator and bounds checks.
+func (bf *BitField) bit(pos uint64) bool {
+	return bf.data[pos/8]&bf.posMask(pos%8, pos%8+1) != 0
+}
+
+// putBit sets the bit at pos to value, bypassing the manipulator and bounds checks.
+func (bf *BitField) putBit(pos uint64, value bool) {
+	if mask := bf.posMask(pos%8, pos%8+1); value {
+		bf.data[pos/8] |= mask
+	} else {
+		bf.data[pos/8] &^= mask
+	}
+}
+
+// copyBits copies count logical bits of src starting at srcOffset into dst starting at dstOffset.
+// The bits are copied position by position, so src and dst may use different manipulators.
+func copyBits(dst *BitField, dstOffset uint64, src *BitField, srcOffset, count uint64) {
+	for i := uint64(0); i < count; i++ {
+		dst.putBit(dstOffset+i, src.bit(srcOffset+i))
+	}
+}
+
 func (bf *BitField) SetBit(pos uint64) error {
 	if bf.err == nil {
 		bf.err = bf.manipulator.SetBit(bf, pos)
@@ -61,10 +348,23 @@ func (bf *BitField) ToggleBit(pos uint64) error {
 	return bf.err
 }
 
+// SetBitTo sets the bit at pos to 1 if value is true and clears it to 0 otherwise.
+func (bf *BitField) SetBitTo(pos uint64, value bool) error {
+	if value {
+		return bf.SetBit(pos)
+	}
+	return bf.ClearBit(pos)
+}
+
 func (bf *BitField) TestBit(pos uint64) (bool, error) {
+	if bf.err != nil {
+		return false, bf.err
+	}
 	return bf.manipulator.TestBit(bf, pos)
 }
 
+// InsertUint64 sets size bits starting at offset to the lowest size bits of value, using the manipulator's
+// bit order. A zero size writes nothing and succeeds for any offset up to the size of the BitField.
 func (bf *BitField) InsertUint64(offset, size, value uint64) error {
 	if bf.err == nil {
 		bf.err = bf.manipulator.InsertUint64(bf, offset, size, value)
@@ -73,5 +373,8 @@ func (bf *BitField) InsertUint64(offset, size, value uint64) error {
 }
 
 func (bf *BitField) ExtractUint64(offset, size uint64) (uint64, error) {
+	if bf.err != nil {
+		return 0, bf.err
+	}
 	return bf.manipulator.ExtractUint64(bf, offset, size)
 }
@@ -1,7 +1,10 @@
 package bitfield
 
 import (
+	"errors"
+	"math"
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -13,10 +16,34 @@ type NewTestCase struct {
 	expectedLen int    // Expected length of the underlying byte slice
 }
 
+type NewWithCapacityTestCase struct {
+	name             string // Name of the test case
+	n                uint64 // Input size in bits for the NewWithCapacity function
+	capacityBits     uint64 // Input capacity in bits for the NewWithCapacity function
+	expectedLen      int    // Expected length of the underlying byte slice
+	expectedCapacity int    // Expected capacity of the underlying byte slice
+}
+
+type FromBytesCheckedTestCase struct {
+	name          string         // Name of the test case
+	bytes         []byte         // Input byte slice
+	size          uint64         // Input size in bits
+	manipulator   BitManipulator // Manipulator of the BitField
+	expectedError error          // Expected error, or nil for none
+}
+
+type WithManipulatorTestCase struct {
+	name         string         // Name of the test case
+	bf           *BitField      // BitField to convert
+	manipulator  BitManipulator // Manipulator to convert to
+	expectedBits []byte         // Expected byte slice of the converted BitField
+}
+
 type FromBytesTestCase struct {
-	name  string // Name of the test case
-	bytes []byte // Expected byte slice after copying the bits
-	size  uint64 // Expected length of the underlying byte slice
+	name         string // Name of the test case
+	bytes        []byte // Byte slice to create the BitField from
+	size         uint64 // Size of the BitField in bits
+	expectedBits []byte // Expected underlying byte slice of the BitField
 }
 
 type BytesTestCase struct {
@@ -31,6 +58,12 @@ type SizeTestCase struct {
 	expectedSize uint64    // Expected size of the BitField
 }
 
+type ByteLenTestCase struct {
+	name            string    // Name of the test case
+	bf              *BitField // Initial BitField for the test
+	expectedByteLen int       // Expected number of bytes covering the BitField
+}
+
 type SetBitTestCase struct {
 	name         string    // Name of the test case
 	bf           *BitField // Initial BitField for the test
@@ -73,6 +106,13 @@ type InsertUint64TestCase struct {
 	expectedBits []byte    // The expected bits after insertion.
 }
 
+type AlignedUint64TestCase struct {
+	name   string // The name of the test case.
+	offset uint64 // The byte-aligned offset of the value.
+	size   uint64 // The byte-aligned size of the value.
+	value  uint64 // The value to insert.
+}
+
 type ExtractUint64TestCase struct {
 	name          string    // name of the test case
 	bf            *BitField // BitField instance
@@ -82,6 +122,21 @@ type ExtractUint64TestCase struct {
 	expectedValue uint64    // expected extracted value
 }
 
+// wrappedManipulator forwards to an included manipulator through the BitManipulator interface only,
+// like an implementation outside the package, so its bit numbering has to be probed.
+type wrappedManipulator struct {
+	BitManipulator
+}
+
+// shiftedManipulator numbers the bits within each byte unlike either included manipulator.
+type shiftedManipulator struct {
+	BitManipulator
+}
+
+func (sm shiftedManipulator) SetBit(bf *BitField, pos uint64) error {
+	return sm.BitManipulator.SetBit(bf, pos+1)
+}
+
 // Test cases
 
 var newTestCases = []NewTestCase{
@@ -107,15 +162,207 @@ var newTestCases = []NewTestCase{
 	},
 }
 
+var newWithCapacityTestCases = []NewWithCapacityTestCase{
+	{
+		name:             "Zero size and capacity",
+		n:                0,
+		capacityBits:     0,
+		expectedLen:      0,
+		expectedCapacity: 0,
+	},
+	{
+		name:             "Capacity rounded up to a power of two",
+		n:                10,
+		capacityBits:     100,
+		expectedLen:      2,
+		expectedCapacity: 16, // 100 bits require 13 bytes
+	},
+	{
+		name:             "Power of two capacity kept",
+		n:                1,
+		capacityBits:     64,
+		expectedLen:      1,
+		expectedCapacity: 8,
+	},
+	{
+		name:             "Capacity smaller than size",
+		n:                24,
+		capacityBits:     8,
+		expectedLen:      3,
+		expectedCapacity: 4, // The size takes precedence over the capacity
+	},
+}
+
+var fromBytesCheckedTestCases = []FromBytesCheckedTestCase{
+	{
+		name:        "Exact bytes",
+		bytes:       []byte{0xAB, 0xCD},
+		size:        16,
+		manipulator: LittleEndian,
+	},
+	{
+		name:        "Clean padding LE",
+		bytes:       []byte{0xAB, 0b00001101},
+		size:        12,
+		manipulator: LittleEndian,
+	},
+	{
+		name:        "Clean padding BE",
+		bytes:       []byte{0xAB, 0b11010000},
+		size:        12,
+		manipulator: BigEndian,
+	},
+	{
+		name:        "Empty input",
+		bytes:       []byte{},
+		size:        0,
+		manipulator: BigEndian,
+	},
+	{
+		name:          "Size larger than the bytes",
+		bytes:         []byte{0xAB},
+		size:          9,
+		manipulator:   LittleEndian,
+		expectedError: ErrInvalidSize,
+	},
+	{
+		name:          "Size that would overflow",
+		bytes:         []byte{},
+		size:          math.MaxUint64,
+		manipulator:   LittleEndian,
+		expectedError: ErrInvalidSize,
+	},
+	{
+		name:          "Bytes beyond the size",
+		bytes:         []byte{0xAB, 0x00},
+		size:          8,
+		manipulator:   BigEndian,
+		expectedError: ErrInvalidSize,
+	},
+	{
+		name:          "Dirty padding LE",
+		bytes:         []byte{0xAB, 0b00011101},
+		size:          12,
+		manipulator:   LittleEndian,
+		expectedError: ErrInvalidEncoding,
+	},
+	{
+		name:          "Dirty padding BE",
+		bytes:         []byte{0xAB, 0b11010001},
+		size:          12,
+		manipulator:   BigEndian,
+		expectedError: ErrInvalidEncoding,
+	},
+}
+
+// alignedUint64Field is the initial content of the 128-bit BitFields used by alignedUint64TestCases.
+var alignedUint64Field = []byte{
+	0b10100101, 0b11110000, 0b00001111, 0b11001100,
+	0b00110011, 0b10101010, 0b01010101, 0b11111111,
+	0b00000000, 0b10000001, 0b01111110, 0b10011001,
+	0b01100110, 0b11100111, 0b00011000, 0b11000011,
+}
+
+var alignedUint64TestCases = []AlignedUint64TestCase{
+	{
+		name:   "Full 64 bits at start",
+		offset: 0,
+		size:   64,
+		value:  0x0123456789ABCDEF,
+	},
+	{
+		name:   "Full 64 bits at end",
+		offset: 64,
+		size:   64,
+		value:  0xFEDCBA9876543210,
+	},
+	{
+		name:   "Single byte",
+		offset: 40,
+		size:   8,
+		value:  0b10010110,
+	},
+	{
+		name:   "Three bytes with extra high bits",
+		offset: 16,
+		size:   24,
+		value:  0xFF00ABCDEF,
+	},
+	{
+		name:   "Zero size",
+		offset: 128,
+		size:   0,
+		value:  0xFF,
+	},
+}
+
+var withManipulatorTestCases = []WithManipulatorTestCase{
+	{
+		name:         "Empty BitField",
+		bf:           LittleEndian.New(0),
+		manipulator:  BigEndian,
+		expectedBits: []byte{},
+	},
+	{
+		name:         "LittleEndian to BigEndian",
+		bf:           LittleEndian.FromBytes([]byte{0b00010011, 0b00000010}, 16),
+		manipulator:  BigEndian,
+		expectedBits: []byte{0b11001000, 0b01000000},
+	},
+	{
+		name:         "BigEndian to LittleEndian",
+		bf:           BigEndian.FromBytes([]byte{0b11001000, 0b01000000}, 16),
+		manipulator:  LittleEndian,
+		expectedBits: []byte{0b00010011, 0b00000010},
+	},
+	{
+		name:         "Padding bits dropped",
+		bf:           LittleEndian.FromBytes([]byte{0b11110001}, 4),
+		manipulator:  BigEndian,
+		expectedBits: []byte{0b10000000},
+	},
+	{
+		name:         "Same manipulator",
+		bf:           BigEndian.FromBytes([]byte{0b10100000}, 3),
+		manipulator:  BigEndian,
+		expectedBits: []byte{0b10100000},
+	},
+}
+
 var fromBytesTestCases = []FromBytesTestCase{
 	{
-		name:  "Empty BitField",
-		bytes: []byte{},
+		name:         "Empty BitField",
+		bytes:        []byte{},
+		expectedBits: []byte{},
+	},
+	{
+		name:         "Set bits",
+		bytes:        []byte{0b11111111, 0b11111111},
+		size:         16,
+		expectedBits: []byte{0b11111111, 0b11111111},
 	},
 	{
-		name:  "Set bits",
-		bytes: []byte{0b11111111, 0b11111111},
-		size:  16,
+		name:         "Size larger than bytes",
+		bytes:        []byte{0b11111111},
+		size:         20,
+		expectedBits: []byte{0b11111111, 0b00000000, 0b00000000}, // Missing bytes are zero-filled
+	},
+	{
+		name:         "Size smaller than bytes",
+		bytes:        []byte{0b11111111, 0b11111111, 0b11111111},
+		size:         10,
+		expectedBits: []byte{0b11111111, 0b11111111}, // Bytes beyond the size are dropped
+	},
+	{
+		name:         "Size leaving padding bits",
+		bytes:        []byte{0b10101010, 0b11111111},
+		size:         12,
+		expectedBits: []byte{0b10101010, 0b11111111},
+	},
+	{
+		name:         "Nil bytes",
+		size:         8,
+		expectedBits: []byte{0b00000000},
 	},
 }
 
@@ -153,6 +400,81 @@ var sizeTestCases = []SizeTestCase{
 	},
 }
 
+var byteLenTestCases = []ByteLenTestCase{
+	{
+		name:            "Empty BitField",
+		bf:              LittleEndian.New(0),
+		expectedByteLen: 0,
+	},
+	{
+		name:            "Single bit",
+		bf:              BigEndian.New(1),
+		expectedByteLen: 1,
+	},
+	{
+		name:            "Byte-aligned size",
+		bf:              LittleEndian.New(16),
+		expectedByteLen: 2,
+	},
+	{
+		name:            "One bit past a byte boundary",
+		bf:              BigEndian.New(17),
+		expectedByteLen: 3,
+	},
+	{
+		name:            "Non-byte-aligned size",
+		bf:              LittleEndian.New(31),
+		expectedByteLen: 4,
+	},
+}
+
+func TestNewFromBytesChecked(t *testing.T) {
+	for _, tc := range fromBytesCheckedTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bf, err := NewFromBytesChecked(tc.bytes, tc.size, tc.manipulator)
+
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("NewFromBytesChecked() got error %v, want %v", err, tc.expectedError)
+				return
+			}
+
+			if tc.expectedError == nil && !bf.StrictEqual(tc.manipulator.FromBytes(tc.bytes, tc.size)) {
+				t.Errorf("NewFromBytesChecked() got %v, want %v", bf, tc.manipulator.FromBytes(tc.bytes, tc.size))
+			}
+			if tc.expectedError != nil && bf != nil {
+				t.Errorf("NewFromBytesChecked() got %v with an error, want nil", bf)
+			}
+		})
+	}
+}
+
+func TestByteCount(t *testing.T) {
+	for size, expected := range map[uint64]uint64{
+		0:              0,
+		1:              1,
+		8:              1,
+		9:              2,
+		math.MaxUint64: math.MaxUint64/8 + 1, // (size+7)/8 would wrap around to 0
+	} {
+		if n := byteCount(size); n != expected {
+			t.Errorf("byteCount(%d) got %d, want %d", size, n, expected)
+		}
+	}
+}
+
+func TestFromBytesSizeOverflow(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("FromBytes() with size %d expected a panic, but got none", uint64(math.MaxUint64))
+				}
+			}()
+			m.FromBytes(nil, math.MaxUint64)
+		}()
+	}
+}
+
 func TestBytes(t *testing.T) {
 	for _, tc := range bytesTestCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -165,6 +487,112 @@ func TestBytes(t *testing.T) {
 	}
 }
 
+func TestRawBytes(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.FromBytes([]byte{0b10101010, 0b01010101}, 12)
+		raw := bf.RawBytes()
+
+		if !reflect.DeepEqual(raw, bf.Bytes()) {
+			t.Errorf("RawBytes() got %v, want %v", raw, bf.Bytes())
+		}
+
+		// Mutations of the BitField are visible through the returned slice
+		bf.SetBit(0)
+		if !reflect.DeepEqual(raw, bf.Bytes()) {
+			t.Errorf("RawBytes() after SetBit got %v, want %v", raw, bf.Bytes())
+		}
+
+		// Writes to the returned slice modify the BitField
+		raw[0] = 0
+		if bf.OnesCount() != 2 {
+			t.Errorf("OnesCount() after writing to RawBytes() got %d, want %d", bf.OnesCount(), 2)
+		}
+	}
+}
+
+func TestNewWithCapacityGrow(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := NewWithCapacity(12, 200, m)
+		bf.InsertUint64(0, 12, 0xABC)
+		data := &bf.data[0]
+
+		bf.Resize(100)
+		bf.Append(m.FromBytes([]byte{0xFF, 0xFF}, 16))
+		if &bf.data[0] != data {
+			t.Errorf("Resize() and Append() within the capacity reallocated the underlying data")
+		}
+
+		if v, err := bf.ExtractUint64(0, 12); err != nil || v != 0xABC {
+			t.Errorf("ExtractUint64() got (%#x, %v), want (0xabc, nil)", v, err)
+		}
+		if bf.OnesCount() != 7+16 {
+			t.Errorf("OnesCount() got %d, want %d", bf.OnesCount(), 7+16)
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		original := m.FromBytes([]byte{0b10101010, 0b00000011}, 10)
+		original.err = errors.New("previous error")
+
+		clone := original.Clone()
+		if !reflect.DeepEqual(clone.data, original.data) || clone.size != original.size || clone.manipulator != original.manipulator {
+			t.Errorf("Clone() got %+v, want a copy of %+v", clone, original)
+		}
+		if clone.err != nil {
+			t.Errorf("Clone() got error %v, want nil", clone.err)
+		}
+
+		// Mutating the clone must not affect the original
+		clone.ToggleBit(0)
+		if original.data[0] != 0b10101010 {
+			t.Errorf("Clone() mutation leaked into the original: %v", original.data)
+		}
+
+		// Mutating the original must not affect the clone
+		original.data[1] = 0b00000000
+		if clone.data[1] != 0b00000011 {
+			t.Errorf("Clone() original mutation leaked into the clone: %v", clone.data)
+		}
+	}
+}
+
+func TestWithManipulator(t *testing.T) {
+	for _, tc := range withManipulatorTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted := tc.bf.WithManipulator(tc.manipulator)
+
+			if !reflect.DeepEqual(converted.data, tc.expectedBits) {
+				t.Errorf("WithManipulator() got %v, want %v", converted.data, tc.expectedBits)
+			}
+			if converted.size != tc.bf.size || converted.manipulator != tc.manipulator {
+				t.Errorf("WithManipulator() got size %d and manipulator %v, want %d and %v",
+					converted.size, converted.manipulator, tc.bf.size, tc.manipulator)
+			}
+			if !converted.EqualLogical(tc.bf) {
+				t.Errorf("WithManipulator() got %v, want the logical bits of %v", converted, tc.bf)
+			}
+
+			// Converting back restores the original bits
+			if back := converted.WithManipulator(tc.bf.manipulator); !back.Equal(tc.bf) {
+				t.Errorf("WithManipulator() round trip got %v, want %v", back, tc.bf)
+			}
+		})
+	}
+}
+
+func TestManipulator(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		if got := m.New(8).Manipulator(); got != m {
+			t.Errorf("Manipulator() got %v, want %v", got, m)
+		}
+		if got := m.FromBytes([]byte{0xFF}, 8).Manipulator(); got != m {
+			t.Errorf("Manipulator() got %v, want %v", got, m)
+		}
+	}
+}
+
 func TestSize(t *testing.T) {
 	for _, tc := range sizeTestCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -177,6 +605,76 @@ func TestSize(t *testing.T) {
 	}
 }
 
+func TestByteLen(t *testing.T) {
+	for _, tc := range byteLenTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.bf.ByteLen()
+			// Compare the byte length with the length of the copied bytes
+			if c != tc.expectedByteLen || c != len(tc.bf.Bytes()) {
+				t.Errorf("ByteLen() got %v, want %v", c, tc.expectedByteLen)
+			}
+		})
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	bf := BigEndian.FromBytes([]byte{0b10110100, 0b11011111}, 12)
+	bf.SetBit(11)
+
+	size, data := bf.Snapshot()
+	if expected := []byte{0b10110100, 0b11010000}; size != 12 || !reflect.DeepEqual(data, expected) {
+		t.Errorf("Snapshot() got (%d, %08b), want (%d, %08b)", size, data, 12, expected)
+	}
+
+	// The data is a copy that does not alias the BitField
+	data[0] = 0
+	if bf.data[0] != 0b10110100 {
+		t.Errorf("Snapshot() returned data sharing the underlying data")
+	}
+}
+
+func TestCustomManipulator(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		wrapped := wrappedManipulator{m}
+		if _, ok := BitManipulator(wrapped).(bitOrder); ok {
+			t.Fatalf("wrappedManipulator unexpectedly reports its bit order")
+		}
+		if msb0(wrapped) != msb0(m) {
+			t.Errorf("msb0() of wrapped %v got %t, want %t", m, msb0(wrapped), msb0(m))
+		}
+
+		custom, builtin := NewWithCapacity(20, 20, wrapped), m.New(20)
+		for _, f := range []*BitField{custom, builtin} {
+			f.SetBit(3)
+			f.InsertUint64(5, 11, 0x5A3)
+			f.SetRange(17, 2)
+		}
+
+		if !reflect.DeepEqual(custom.data, builtin.data) || custom.String() != builtin.String() {
+			t.Errorf("custom manipulator got %v, want %v", custom, builtin)
+		}
+		if pos, _ := custom.FindNextSet(4); pos != 5 {
+			t.Errorf("FindNextSet() with custom manipulator got %d, want %d", pos, 5)
+		}
+		if allocs := testing.AllocsPerRun(10, func() { custom.FindNextSet(4); custom.OnesCount() }); allocs != 0 {
+			t.Errorf("byte-wise methods with custom manipulator allocated %v times, want 0", allocs)
+		}
+	}
+}
+
+func TestCustomManipulatorUnsupportedBitOrder(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewWithCapacity() with shifted %v expected a panic, but got none", m)
+				}
+			}()
+			NewWithCapacity(16, 16, shiftedManipulator{m})
+		}()
+	}
+}
+
 func TestError(t *testing.T) {
 	var name string = "Error between mutations"
 	var bfSize uint64 = 32
@@ -202,3 +700,174 @@ func TestError(t *testing.T) {
 		t.Errorf("%s: expected %v, got %v", name, expectedBitsA, bf.data)
 	}
 }
+
+func TestSetBitTo(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(12)
+		var pos uint64 = 9
+
+		if err := bf.SetBitTo(pos, true); err != nil {
+			t.Fatalf("SetBitTo(%d, true) returned unexpected error: %v", pos, err)
+		}
+		if got, _ := bf.TestBit(pos); !got {
+			t.Errorf("SetBitTo(%d, true) got bit %v, want true", pos, got)
+		}
+
+		if err := bf.SetBitTo(pos, false); err != nil {
+			t.Fatalf("SetBitTo(%d, false) returned unexpected error: %v", pos, err)
+		}
+		if got, _ := bf.TestBit(pos); got {
+			t.Errorf("SetBitTo(%d, false) got bit %v, want false", pos, got)
+		}
+		if bf.OnesCount() != 0 {
+			t.Errorf("SetBitTo() left %d bits set, want 0", bf.OnesCount())
+		}
+
+		// An out of range position sets the sticky error, after which writes are no-ops
+		if err := bf.SetBitTo(12, true); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("SetBitTo(12, true) got error %v, want %v", err, ErrOutOfRange)
+		}
+		if err := bf.SetBitTo(0, true); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("SetBitTo() after error got %v, want %v", err, ErrOutOfRange)
+		}
+		if bf.OnesCount() != 0 {
+			t.Errorf("SetBitTo() after error modified the BitField: %v", bf)
+		}
+	}
+}
+
+func TestZero(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.FromBytes([]byte{0b10101010, 0b11111111}, 12)
+		bf.SetBit(20) // Sets the sticky error
+		capacity := cap(bf.data)
+
+		bf.Zero()
+
+		fresh := m.New(12)
+		if !reflect.DeepEqual(bf.data, fresh.data) || bf.size != fresh.size || bf.manipulator != fresh.manipulator {
+			t.Errorf("Zero() got %+v, want %+v", bf, fresh)
+		}
+		if bf.err != nil {
+			t.Errorf("Zero() got error %v, want nil", bf.err)
+		}
+		if cap(bf.data) != capacity {
+			t.Errorf("Zero() got capacity %d, want %d", cap(bf.data), capacity)
+		}
+
+		// The reused BitField behaves like the fresh one
+		for _, f := range []*BitField{bf, fresh} {
+			f.InsertUint64(2, 8, 0b10110011)
+			f.ToggleBit(11)
+		}
+		if !bf.StrictEqual(fresh) || bf.err != nil {
+			t.Errorf("Zero() reuse got %v, want %v", bf, fresh)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	bf := LittleEndian.FromBytes([]byte{0xFF, 0xFF, 0xFF, 0xFF}, 32)
+	bf.SetBit(32) // Sets the sticky error
+	buffer := &bf.data[0]
+
+	// A smaller field reuses the buffer without stale bits
+	bf.Reset(10, BigEndian)
+	if !bf.StrictEqual(BigEndian.New(10)) || len(bf.data) != 2 || bf.HasDirtyPadding() {
+		t.Errorf("Reset() got %+v, want a cleared 10-bit BigEndian field", bf)
+	}
+	if &bf.data[0] != buffer {
+		t.Errorf("Reset() reallocated the data, want the existing buffer reused")
+	}
+	if bf.err != nil {
+		t.Errorf("Reset() got error %v, want nil", bf.err)
+	}
+
+	// Growing back within the capacity exposes no stale bits either
+	bf.Reset(32, LittleEndian)
+	if !bf.StrictEqual(LittleEndian.New(32)) || &bf.data[0] != buffer {
+		t.Errorf("Reset() got %+v, want a cleared 32-bit field in the existing buffer", bf)
+	}
+
+	// A larger field than the capacity allows is reallocated
+	bf.Reset(40, LittleEndian)
+	if !bf.StrictEqual(LittleEndian.New(40)) {
+		t.Errorf("Reset() got %+v, want a cleared 40-bit field", bf)
+	}
+}
+
+func TestResetWithPool(t *testing.T) {
+	pool := sync.Pool{New: func() any { return LittleEndian.New(64) }}
+
+	bf := pool.Get().(*BitField)
+	bf.FillPattern(0xAA)
+	pool.Put(bf)
+
+	reused := pool.Get().(*BitField)
+	reused.Reset(20, BigEndian)
+	if reused.Any() {
+		t.Errorf("Reset() left stale bits: %v", reused)
+	}
+}
+
+func TestReadAfterError(t *testing.T) {
+	bf := BigEndian.FromBytes([]byte{0b11111111}, 8)
+
+	poison := bf.SetBit(8)
+	if poison == nil {
+		t.Errorf("SetBit() out of range expected an error, but got none")
+	}
+
+	if bit, err := bf.TestBit(0); err != poison || bit {
+		t.Errorf("TestBit() after error got (%t, %v), want (false, %v)", bit, err, poison)
+	}
+	if value, err := bf.ExtractUint64(0, 8); err != poison || value != 0 {
+		t.Errorf("ExtractUint64() after error got (%d, %v), want (0, %v)", value, err, poison)
+	}
+	reads := map[string]func() error{
+		"ExtractBytes":   func() error { _, err := bf.ExtractBytes(0, 8); return err },
+		"ExtractBools":   func() error { _, err := bf.ExtractBools(0, 8); return err },
+		"ExtractBigInt":  func() error { _, err := bf.ExtractBigInt(0, 8); return err },
+		"Slice":          func() error { _, err := bf.Slice(0, 8); return err },
+		"OnesCountRange": func() error { _, err := bf.OnesCountRange(0, 8); return err },
+		"AllInRange":     func() error { _, err := bf.AllInRange(0, 8); return err },
+		"NoneInRange":    func() error { _, err := bf.NoneInRange(0, 8); return err },
+		"Rank":           func() error { _, err := bf.Rank(8); return err },
+		"AndCount":       func() error { _, err := bf.AndCount(bf); return err },
+	}
+	for name, read := range reads {
+		if err := read(); err != poison {
+			t.Errorf("%s() after error got %v, want %v", name, err, poison)
+		}
+	}
+
+	// A failing read does not poison the BitField
+	bf.ClearError()
+	if _, err := bf.TestBit(8); err == nil {
+		t.Errorf("TestBit() out of range expected an error, but got none")
+	}
+	if err := bf.Error(); err != nil {
+		t.Errorf("Error() after failing read got %v, want nil", err)
+	}
+}
+
+func TestClearError(t *testing.T) {
+	bf := LittleEndian.New(8)
+
+	if err := bf.SetBit(8); err == nil {
+		t.Errorf("SetBit() out of range expected an error, but got none")
+	}
+
+	bf.ClearError()
+	if err := bf.Error(); err != nil {
+		t.Errorf("Error() after ClearError() got %v, want nil", err)
+	}
+
+	// Subsequent operations must succeed again
+	if err := bf.SetBit(7); err != nil {
+		t.Errorf("SetBit() after ClearError() returned unexpected error: %v", err)
+	}
+	if expected := []byte{0b10000000}; !reflect.DeepEqual(bf.data, expected) {
+		t.Errorf("SetBit() after ClearError() got %v, want %v", bf.data, expected)
+	}
+}
@@ -0,0 +1,123 @@
+package bitfield
+
+import (
+	"math/bits"
+)
+
+// checkCompatible returns the sticky error of the BitField, or an error if other cannot be combined with
+// the BitField byte by byte, which requires both BitFields to have the same size and manipulator.
+func (bf *BitField) checkCompatible(other *BitField) error {
+	if bf.err != nil {
+		return bf.err
+	}
+	if bf.size != other.size {
+		return ErrSizeMismatch
+	}
+	if bf.manipulator != other.manipulator {
+		return ErrManipulatorMismatch
+	}
+	return nil
+}
+
+// combine returns a new BitField whose bytes are the result of applying op to the
+// corresponding bytes of bf and other. The padding bits of the result are cleared.
+func (bf *BitField) combine(other *BitField, op func(a, b byte) byte) (*BitField, error) {
+	if err := bf.checkCompatible(other); err != nil {
+		return nil, err
+	}
+
+	result := bf.sibling(bf.size)
+	for i := range result.data {
+		result.data[i] = op(bf.data[i], other.data[i])
+	}
+	result.clearPadding()
+	return result, nil
+}
+
+// combineCount returns the number of bits set in the result of applying op to the corresponding bytes
+// of bf and other, without allocating the result. Padding bits are not counted.
+func (bf *BitField) combineCount(other *BitField, op func(a, b byte) byte) (uint64, error) {
+	if err := bf.checkCompatible(other); err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		count += uint64(bits.OnesCount8(op(bf.data[i], other.data[i]) &^ bf.paddingMaskAt(i)))
+	}
+	return count, nil
+}
+
+// combineNone reports whether applying op to the corresponding bytes of bf and other yields no set bit,
+// stopping at the first byte that does. Padding bits are ignored.
+func (bf *BitField) combineNone(other *BitField, op func(a, b byte) byte) (bool, error) {
+	if err := bf.checkCompatible(other); err != nil {
+		return false, err
+	}
+
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if op(bf.data[i], other.data[i])&^bf.paddingMaskAt(i) != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// And returns a new BitField containing the bitwise AND of the BitField and other.
+// It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) And(other *BitField) (*BitField, error) {
+	return bf.combine(other, func(a, b byte) byte { return a & b })
+}
+
+// Or returns a new BitField containing the bitwise OR of the BitField and other.
+// It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) Or(other *BitField) (*BitField, error) {
+	return bf.combine(other, func(a, b byte) byte { return a | b })
+}
+
+// Xor returns a new BitField containing the bitwise XOR of the BitField and other.
+// It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) Xor(other *BitField) (*BitField, error) {
+	return bf.combine(other, func(a, b byte) byte { return a ^ b })
+}
+
+// Not inverts every bit of the BitField in place. Padding bits beyond the size of the BitField remain cleared.
+func (bf *BitField) Not() error {
+	if bf.err == nil {
+		for i := range bf.data {
+			bf.data[i] = ^bf.data[i]
+		}
+		bf.clearPadding()
+	}
+	return bf.err
+}
+
+// AndNot returns a new BitField containing the bits of the BitField that are not set in other.
+// It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) AndNot(other *BitField) (*BitField, error) {
+	return bf.combine(other, func(a, b byte) byte { return a &^ b })
+}
+
+// AndCount returns the number of bits set in both the BitField and other, which is the OnesCount of
+// their And without allocating it. It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) AndCount(other *BitField) (uint64, error) {
+	return bf.combineCount(other, func(a, b byte) byte { return a & b })
+}
+
+// OrCount returns the number of bits set in the BitField, other or both, which is the OnesCount of
+// their Or without allocating it. It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) OrCount(other *BitField) (uint64, error) {
+	return bf.combineCount(other, func(a, b byte) byte { return a | b })
+}
+
+// IsSubsetOf reports whether every bit set in the BitField is also set in other.
+// It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) IsSubsetOf(other *BitField) (bool, error) {
+	return bf.combineNone(other, func(a, b byte) byte { return a &^ b })
+}
+
+// IsDisjoint reports whether no bit is set in both the BitField and other.
+// It returns an error if the two BitFields differ in size or manipulator.
+func (bf *BitField) IsDisjoint(other *BitField) (bool, error) {
+	return bf.combineNone(other, func(a, b byte) byte { return a & b })
+}
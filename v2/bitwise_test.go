@@ -0,0 +1,834 @@
+package bitfield
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type BinaryOpTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Left-hand operand
+	other        *BitField // Right-hand operand
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice of the result
+}
+
+type NotTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice after inverting the bits
+}
+
+type CountBinaryOpTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Left-hand operand
+	other         *BitField // Right-hand operand
+	expectError   bool      // Whether an error is expected
+	expectedCount uint64    // Expected number of bits set in the result
+}
+
+type RelationTestCase struct {
+	name             string    // Name of the test case
+	bf               *BitField // Left-hand operand
+	other            *BitField // Right-hand operand
+	expectError      bool      // Whether an error is expected
+	expectedRelation bool      // Whether the relation is expected to hold
+}
+
+// Test cases
+
+var andTestCases = []BinaryOpTestCase{
+	{
+		name: "Equal size LE",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b10101010},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b11111111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b10100000, 0b10101010},
+	},
+	{
+		name: "Equal size BE",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b10101010},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b00111100, 0b00001111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b00110000, 0b00001010},
+	},
+	{
+		name: "Non-multiple of 8 size LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b01010101, 0b11111111},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b01010101, 0b00000011}, // Padding bits are dropped
+	},
+	{
+		name: "Non-multiple of 8 size BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b01010101, 0b11111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b01010101, 0b11000000}, // Padding bits are dropped
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          LittleEndian.New(16),
+		other:       LittleEndian.New(8),
+		expectError: true,
+	},
+	{
+		name:        "Mismatched manipulators",
+		bf:          LittleEndian.New(16),
+		other:       BigEndian.New(16),
+		expectError: true,
+	},
+}
+
+var orTestCases = []BinaryOpTestCase{
+	{
+		name: "Identity with all-zero field",
+		bf: &BitField{
+			data:        []byte{0b10010110, 0b00111100},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other:        LittleEndian.New(16),
+		expectedBits: []byte{0b10010110, 0b00111100},
+	},
+	{
+		name: "All-ones field LE",
+		bf:   LittleEndian.New(12),
+		other: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b11111111, 0b00001111}, // Padding bits stay zero
+	},
+	{
+		name: "All-ones field BE",
+		bf:   BigEndian.New(12),
+		other: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b11111111, 0b11110000}, // Padding bits stay zero
+	},
+	{
+		name: "Union of disjoint bits BE",
+		bf: &BitField{
+			data:        []byte{0b10000000},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b10000001},
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          BigEndian.New(9),
+		other:       BigEndian.New(10),
+		expectError: true,
+	},
+}
+
+var xorFieldLE = &BitField{
+	data:        []byte{0b10110100, 0b11111101},
+	size:        10,
+	manipulator: LittleEndian,
+}
+
+var xorFieldBE = &BitField{
+	data:        []byte{0b10110100, 0b10111111},
+	size:        10,
+	manipulator: BigEndian,
+	msb0:        true,
+}
+
+var xorTestCases = []BinaryOpTestCase{
+	{
+		name: "Symmetric difference LE",
+		bf: &BitField{
+			data:        []byte{0b11001100, 0b00000011},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b00000001},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b01100110, 0b00000010},
+	},
+	{
+		name: "Symmetric difference BE",
+		bf: &BitField{
+			data:        []byte{0b11001100, 0b11000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b10000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b01100110, 0b01000000},
+	},
+	{
+		name:         "Self XOR LE",
+		bf:           xorFieldLE,
+		other:        xorFieldLE,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name:         "Self XOR BE",
+		bf:           xorFieldBE,
+		other:        xorFieldBE,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Differing padding LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11110000},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Differing padding BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00001111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          LittleEndian.New(10),
+		other:       LittleEndian.New(12),
+		expectError: true,
+	},
+}
+
+var andNotTestCases = []BinaryOpTestCase{
+	{
+		name: "Clear bits set in other",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00001111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b00000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b01010101, 0b00001100},
+	},
+	{
+		name: "All-ones mask LE",
+		bf: &BitField{
+			data:        []byte{0b10110110, 0b00000101},
+			size:        11,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b11111111, 0b00000111},
+			size:        11,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Trailing padding masked LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11111001},
+			size:        11,
+			manipulator: LittleEndian,
+		},
+		other:        LittleEndian.New(11),
+		expectedBits: []byte{0b00000000, 0b00000001},
+	},
+	{
+		name: "Trailing padding masked BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b10011111},
+			size:        11,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other:        BigEndian.New(11),
+		expectedBits: []byte{0b00000000, 0b10000000},
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          BigEndian.New(11),
+		other:       BigEndian.New(16),
+		expectError: true,
+	},
+}
+
+var notTestCases = []NotTestCase{
+	{
+		name: "Invert byte-aligned field",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b10101010},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00001111, 0b01010101},
+	},
+	{
+		name: "Invert 10-bit field LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b11111111, 0b00000011}, // Top 6 bits of byte 2 remain zero
+	},
+	{
+		name: "Invert 10-bit field BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b11111111, 0b11000000}, // Low 6 bits of byte 2 remain zero
+	},
+	{
+		name: "Invert with previous error",
+		bf: &BitField{
+			data:        []byte{0b00000000},
+			size:        8,
+			manipulator: LittleEndian,
+			err:         errors.New("previous error"),
+		},
+		expectError:  true,
+		expectedBits: []byte{0b00000000},
+	},
+}
+
+var andCountTestCases = []CountBinaryOpTestCase{
+	{
+		name:          "Empty BitFields",
+		bf:            LittleEndian.New(0),
+		other:         LittleEndian.New(0),
+		expectedCount: 0,
+	},
+	{
+		name: "Disjoint fields",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b10101010},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00001111, 0b01010101},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 0,
+	},
+	{
+		name: "Overlapping fields",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b10101010},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b00111100, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 6,
+	},
+	{
+		name: "Padding bits excluded LE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b11111111},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000011, 0b11111101},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 2, // Positions 0 and 9
+	},
+	{
+		name: "Padding bits excluded BE",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b11111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b11000000, 0b10111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 2, // Positions 0 and 8
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          LittleEndian.New(16),
+		other:       LittleEndian.New(12),
+		expectError: true,
+	},
+	{
+		name:        "Mismatched manipulators",
+		bf:          LittleEndian.New(16),
+		other:       BigEndian.New(16),
+		expectError: true,
+	},
+}
+
+var orCountTestCases = []CountBinaryOpTestCase{
+	{
+		name:          "Empty BitFields",
+		bf:            BigEndian.New(0),
+		other:         BigEndian.New(0),
+		expectedCount: 0,
+	},
+	{
+		name:          "Identical fields",
+		bf:            xorFieldLE,
+		other:         xorFieldLE,
+		expectedCount: 5, // The padding bits of xorFieldLE are not counted
+	},
+	{
+		name: "Complementary fields LE",
+		bf: &BitField{
+			data:        []byte{0b10100101, 0b00000010},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b01011010, 0b00001101},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 12,
+	},
+	{
+		name: "Complementary fields BE",
+		bf: &BitField{
+			data:        []byte{0b10100101, 0b00100000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b01011010, 0b11011111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 12, // The padding bits of other are not counted
+	},
+	{
+		name: "Overlapping fields",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b00000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00111100, 0b00000001},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 7,
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          BigEndian.New(8),
+		other:       BigEndian.New(9),
+		expectError: true,
+	},
+}
+
+var isSubsetOfTestCases = []RelationTestCase{
+	{
+		name:             "Empty set",
+		bf:               LittleEndian.New(16),
+		other:            LittleEndian.FromBytes([]byte{0b00010000, 0b00000000}, 16),
+		expectedRelation: true,
+	},
+	{
+		name: "Proper subset",
+		bf: &BitField{
+			data:        []byte{0b00100100, 0b10000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b01100101, 0b10000001},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedRelation: true,
+	},
+	{
+		name: "Equal sets",
+		bf: &BitField{
+			data:        []byte{0b10110011, 0b01000000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b10110011, 0b01000000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedRelation: true,
+	},
+	{
+		name: "Not a subset",
+		bf: &BitField{
+			data:        []byte{0b00100100, 0b00000010},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b11111111, 0b11111101},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedRelation: false,
+	},
+	{
+		name: "Padding bits ignored LE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b11110000},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001, 0b00000000},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedRelation: true,
+	},
+	{
+		name: "Padding bits ignored BE",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b00001111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b10000000, 0b00000000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedRelation: true,
+	},
+	{
+		name:        "Mismatched sizes",
+		bf:          LittleEndian.New(16),
+		other:       LittleEndian.New(8),
+		expectError: true,
+	},
+}
+
+var isDisjointTestCases = []RelationTestCase{
+	{
+		name:             "Empty BitFields",
+		bf:               LittleEndian.New(0),
+		other:            LittleEndian.New(0),
+		expectedRelation: true,
+	},
+	{
+		name: "Non-overlapping fields",
+		bf: &BitField{
+			data:        []byte{0b10100000, 0b00001111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b01011111, 0b11110000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedRelation: true,
+	},
+	{
+		name: "Overlapping in the first byte",
+		bf: &BitField{
+			data:        []byte{0b00010000, 0b00000000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b00011000, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedRelation: false,
+	},
+	{
+		name: "Overlapping in the last bit",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000010},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b11111111, 0b00000010},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedRelation: false,
+	},
+	{
+		name: "Overlapping within the final byte",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b01111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b01000000, 0b11111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedRelation: false, // Position 9 overlaps
+	},
+	{
+		name: "Only padding bits overlap",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b00111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b01000000, 0b00111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedRelation: true,
+	},
+	{
+		name:        "Mismatched manipulators",
+		bf:          LittleEndian.New(8),
+		other:       BigEndian.New(8),
+		expectError: true,
+	},
+}
+
+// Test functions
+
+func runBinaryOpTest(t *testing.T, name string, op func(bf, other *BitField) (*BitField, error), tc BinaryOpTestCase) {
+	result, err := op(tc.bf, tc.other)
+
+	if (err != nil) != tc.expectError {
+		t.Errorf("%s() returned unexpected error: got %v, want %v", name, err, tc.expectError)
+		return
+	}
+
+	if tc.expectError {
+		return
+	}
+
+	if !reflect.DeepEqual(result.data, tc.expectedBits) {
+		t.Errorf("%s() got %v, want %v", name, result.data, tc.expectedBits)
+	}
+	if result.size != tc.bf.size {
+		t.Errorf("%s() got size %d, want %d", name, result.size, tc.bf.size)
+	}
+	if result.manipulator != tc.bf.manipulator {
+		t.Errorf("%s() got manipulator %v, want %v", name, result.manipulator, tc.bf.manipulator)
+	}
+}
+
+func TestAnd(t *testing.T) {
+	for _, tc := range andTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runBinaryOpTest(t, "And", (*BitField).And, tc)
+		})
+	}
+}
+
+func TestOr(t *testing.T) {
+	for _, tc := range orTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runBinaryOpTest(t, "Or", (*BitField).Or, tc)
+		})
+	}
+}
+
+func TestXor(t *testing.T) {
+	for _, tc := range xorTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runBinaryOpTest(t, "Xor", (*BitField).Xor, tc)
+		})
+	}
+}
+
+func TestAndNot(t *testing.T) {
+	for _, tc := range andNotTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runBinaryOpTest(t, "AndNot", (*BitField).AndNot, tc)
+		})
+	}
+}
+
+func TestNot(t *testing.T) {
+	for _, tc := range notTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bf.Not()
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("Not() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("Not() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func runCountBinaryOpTest(t *testing.T, name string, op func(bf, other *BitField) (uint64, error),
+	materialize func(bf, other *BitField) (*BitField, error), tc CountBinaryOpTestCase) {
+	count, err := op(tc.bf, tc.other)
+
+	if (err != nil) != tc.expectError {
+		t.Errorf("%s() returned unexpected error: got %v, want %v", name, err, tc.expectError)
+		return
+	}
+
+	if count != tc.expectedCount {
+		t.Errorf("%s() got %d, want %d", name, count, tc.expectedCount)
+	}
+
+	// The count matches that of the materialized result
+	if result, err := materialize(tc.bf, tc.other); err == nil && result.OnesCount() != count {
+		t.Errorf("%s() got %d, want OnesCount() of the result %d", name, count, result.OnesCount())
+	}
+}
+
+func TestAndCount(t *testing.T) {
+	for _, tc := range andCountTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runCountBinaryOpTest(t, "AndCount", (*BitField).AndCount, (*BitField).And, tc)
+		})
+	}
+}
+
+func TestOrCount(t *testing.T) {
+	for _, tc := range orCountTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runCountBinaryOpTest(t, "OrCount", (*BitField).OrCount, (*BitField).Or, tc)
+		})
+	}
+}
+
+func runRelationTest(t *testing.T, name string, op func(bf, other *BitField) (bool, error), tc RelationTestCase) {
+	relation, err := op(tc.bf, tc.other)
+
+	if (err != nil) != tc.expectError {
+		t.Errorf("%s() returned unexpected error: got %v, want %v", name, err, tc.expectError)
+		return
+	}
+
+	if relation != tc.expectedRelation {
+		t.Errorf("%s() got %t, want %t", name, relation, tc.expectedRelation)
+	}
+}
+
+func TestIsSubsetOf(t *testing.T) {
+	for _, tc := range isSubsetOfTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRelationTest(t, "IsSubsetOf", (*BitField).IsSubsetOf, tc)
+		})
+	}
+}
+
+func TestIsDisjoint(t *testing.T) {
+	for _, tc := range isDisjointTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRelationTest(t, "IsDisjoint", (*BitField).IsDisjoint, tc)
+			runRelationTest(t, "IsDisjoint reversed", func(bf, other *BitField) (bool, error) { return other.IsDisjoint(bf) }, tc)
+		})
+	}
+}
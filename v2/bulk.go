@@ -0,0 +1,29 @@
+package bitfield
+
+import (
+	"fmt"
+)
+
+// SetBitsAt sets the bits at all of the given positions to 1.
+// All positions are validated before any bit is written, so the BitField is unchanged if an error is returned.
+// Returns an error for the first position, in the order given, that is out of range.
+// The positions are written in the order given.
+func (bf *BitField) SetBitsAt(positions []uint64) error {
+	if bf.err == nil {
+		bf.err = bf.setBitsAt(positions)
+	}
+	return bf.err
+}
+
+func (bf *BitField) setBitsAt(positions []uint64) error {
+	for _, pos := range positions {
+		if pos >= bf.size {
+			return fmt.Errorf("%w: position %d, size %d", ErrOutOfRange, pos, bf.size)
+		}
+	}
+
+	for _, pos := range positions {
+		bf.data[pos/8] |= bf.bitMask(pos)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package bitfield
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// Test case structs
+
+type SetBitsAtTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	positions    []uint64  // Positions of the bits to set
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice after setting the bits
+}
+
+// Test cases
+
+var setBitsAtTestCases = []SetBitsAtTestCase{
+	{
+		name:         "Unordered positions LE",
+		bf:           LittleEndian.New(16),
+		positions:    []uint64{9, 0, 15, 3},
+		expectedBits: []byte{0b00001001, 0b10000010},
+	},
+	{
+		name:         "Unordered positions BE",
+		bf:           BigEndian.New(16),
+		positions:    []uint64{9, 0, 15, 3},
+		expectedBits: []byte{0b10010000, 0b01000001},
+	},
+	{
+		name:         "Duplicate positions",
+		bf:           LittleEndian.New(8),
+		positions:    []uint64{2, 2, 2},
+		expectedBits: []byte{0b00000100},
+	},
+	{
+		name:         "No positions",
+		bf:           LittleEndian.FromBytes([]byte{0b00010000}, 8),
+		positions:    nil,
+		expectedBits: []byte{0b00010000},
+	},
+	{
+		name:         "Out of range position leaves the field unchanged",
+		bf:           LittleEndian.New(12),
+		positions:    []uint64{1, 2, 12, 3},
+		expectError:  true,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Previous error",
+		bf: &BitField{
+			data:        []byte{0b00000000},
+			size:        8,
+			manipulator: LittleEndian,
+			err:         errors.New("previous error"),
+		},
+		positions:    []uint64{0},
+		expectError:  true,
+		expectedBits: []byte{0b00000000},
+	},
+}
+
+// Test functions
+
+func TestSetBitsAt(t *testing.T) {
+	for _, tc := range setBitsAtTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bf.SetBitsAt(tc.positions)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("SetBitsAt() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("SetBitsAt() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestSetBitsAtFirstError(t *testing.T) {
+	bf := LittleEndian.New(8)
+	err := bf.SetBitsAt([]uint64{1, 10, 20})
+
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("SetBitsAt() got error %v, want %v", err, ErrOutOfRange)
+	}
+	if expected := "bit position out of range: position 10, size 8"; err.Error() != expected {
+		t.Errorf("SetBitsAt() got error %q, want %q", err, expected)
+	}
+}
+
+func TestSetBitsAtMatchesSetBit(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bulk, looped := m.New(1000), m.New(1000)
+		positions := randomPositions(200, 1000)
+
+		if err := bulk.SetBitsAt(positions); err != nil {
+			t.Fatalf("SetBitsAt() returned unexpected error: %v", err)
+		}
+		for _, pos := range positions {
+			looped.SetBit(pos)
+		}
+
+		if !bulk.StrictEqual(looped) {
+			t.Errorf("SetBitsAt() got %v, want %v", bulk, looped)
+		}
+	}
+}
+
+// randomPositions returns n reproducible pseudo-random positions below size.
+func randomPositions(n, size int64) []uint64 {
+	r := rand.New(rand.NewSource(1))
+	positions := make([]uint64, n)
+	for i := range positions {
+		positions[i] = uint64(r.Int63n(size))
+	}
+	return positions
+}
+
+func BenchmarkSetBitsAt(b *testing.B) {
+	bf := LittleEndian.New(1 << 20)
+	positions := randomPositions(1<<12, 1<<20)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bf.SetBitsAt(positions)
+	}
+}
+
+func BenchmarkSetBitsAtSorted(b *testing.B) {
+	bf := LittleEndian.New(1 << 20)
+	positions := randomPositions(1<<12, 1<<20)
+	sorted := make([]uint64, len(positions))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(sorted, positions)
+		slices.Sort(sorted)
+		bf.SetBitsAt(sorted)
+	}
+}
+
+func BenchmarkSetBitLoop(b *testing.B) {
+	bf := LittleEndian.New(1 << 20)
+	positions := randomPositions(1<<12, 1<<20)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, pos := range positions {
+			bf.SetBit(pos)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package bitfield
+
+import "hash/crc32"
+
+// Checksum returns the CRC-32 checksum, using table, of the bytes of the BitField with the padding bits cleared.
+// BitFields that hold the same bits under the same manipulator have the same checksum regardless of their padding.
+func (bf *BitField) Checksum(table *crc32.Table) uint32 {
+	n := bf.ByteLen()
+	if n == 0 {
+		return crc32.Checksum(nil, table)
+	}
+
+	crc := crc32.Checksum(bf.data[:n-1], table)
+	return crc32.Update(crc, table, []byte{bf.maskedByte(uint64(n - 1))})
+}
+
+// FNV-1a parameters for 64-bit hashes, as used by hash/fnv.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// Hash returns a 64-bit FNV-1a hash of the size and the logical bits of the BitField. BitFields that are
+// EqualLogical hash equally, regardless of their manipulators and padding bits, so the hash can stand in
+// for the content when grouping BitFields, e.g. as a map key. The hash is not cryptographically secure.
+func (bf *BitField) Hash() uint64 {
+	h := uint64(fnvOffset64)
+	for i := 0; i < 8; i++ {
+		h ^= (bf.size >> (8 * i)) & 0xFF
+		h *= fnvPrime64
+	}
+
+	// Each byte is normalized so that its bits are in the order of their positions.
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		h ^= uint64(bf.normalize(bf.maskedByte(i)))
+		h *= fnvPrime64
+	}
+	return h
+}
@@ -0,0 +1,78 @@
+package bitfield
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"hash/fnv"
+	"testing"
+)
+
+// Test functions
+
+func TestChecksum(t *testing.T) {
+	for _, table := range []*crc32.Table{crc32.IEEETable, crc32.MakeTable(crc32.Castagnoli)} {
+		for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+			clean := m.FromBytes([]byte{0b10110100, 0b00000000}, 12)
+			clean.InsertUint64(8, 4, 0b1001)
+			dirty := clean.Clone()
+			dirty.data[1] |= dirty.paddingMask()
+
+			if !dirty.HasDirtyPadding() || !clean.Equal(dirty) {
+				t.Fatalf("expected logically equal fields with different padding, got %v and %v", clean.data, dirty.data)
+			}
+
+			want := crc32.Checksum(clean.data, table)
+			if got := clean.Checksum(table); got != want {
+				t.Errorf("Checksum() got %#08x, want %#08x", got, want)
+			}
+			if got := dirty.Checksum(table); got != want {
+				t.Errorf("Checksum() with dirty padding got %#08x, want %#08x", got, want)
+			}
+			if !dirty.HasDirtyPadding() {
+				t.Errorf("Checksum() cleared the padding bits of the BitField")
+			}
+
+			// A change to the logical bits changes the checksum
+			dirty.ToggleBit(11)
+			if dirty.Checksum(table) == want {
+				t.Errorf("Checksum() unchanged after toggling a bit")
+			}
+		}
+	}
+}
+
+func TestChecksumEmpty(t *testing.T) {
+	if got, want := LittleEndian.New(0).Checksum(crc32.IEEETable), crc32.ChecksumIEEE(nil); got != want {
+		t.Errorf("Checksum() of empty BitField got %#08x, want %#08x", got, want)
+	}
+}
+
+func TestHash(t *testing.T) {
+	clean := LittleEndian.FromBytes([]byte{0b10110100, 0b00000101}, 12)
+	dirty := LittleEndian.FromBytes([]byte{0b10110100, 0b11110101}, 12)
+	reordered := clean.WithManipulator(BigEndian)
+
+	for _, other := range []*BitField{dirty, reordered} {
+		if clean.Hash() != other.Hash() {
+			t.Errorf("Hash() got %#x and %#x for logically equal fields %v and %v", clean.Hash(), other.Hash(), clean, other)
+		}
+	}
+
+	for _, other := range []*BitField{
+		LittleEndian.FromBytes([]byte{0b10110100, 0b00000100}, 12), // Differs in one bit
+		LittleEndian.FromBytes([]byte{0b10110100, 0b00000101}, 13), // Differs in size
+		LittleEndian.New(12),
+	} {
+		if clean.Hash() == other.Hash() {
+			t.Errorf("Hash() got %#x for differing fields %v and %v", clean.Hash(), clean, other)
+		}
+	}
+
+	// The hash matches hash/fnv over the size and the bytes in LSB 0 order
+	h := fnv.New64a()
+	h.Write(binary.LittleEndian.AppendUint64(nil, 12))
+	h.Write([]byte{0b10110100, 0b00000101})
+	if clean.Hash() != h.Sum64() {
+		t.Errorf("Hash() got %#x, want %#x", clean.Hash(), h.Sum64())
+	}
+}
@@ -0,0 +1,65 @@
+package bitfield
+
+import "cmp"
+
+// Equal reports whether the BitField and other have the same size and the same underlying data.
+// Padding bits beyond the size are ignored. The manipulators are not compared, so two BitFields
+// holding the same logical bits under different manipulators are generally not equal, as their
+// underlying data differs.
+func (bf *BitField) Equal(other *BitField) bool {
+	if bf.size != other.size {
+		return false
+	}
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if bf.maskedByte(i) != other.maskedByte(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualLogical reports whether the BitField and other have the same size and the same bit at every position,
+// reading each bit with the manipulator of its own BitField. Unlike Equal, BitFields holding the same logical
+// bits under different manipulators are equal. The sticky errors of both BitFields are ignored.
+func (bf *BitField) EqualLogical(other *BitField) bool {
+	if bf.size != other.size {
+		return false
+	}
+	for pos := uint64(0); pos < bf.size; pos++ {
+		a, errA := bf.manipulator.TestBit(bf, pos)
+		b, errB := other.manipulator.TestBit(other, pos)
+		if errA != nil || errB != nil || a != b {
+			return false
+		}
+	}
+	return true
+}
+
+// StrictEqual reports whether the BitField and other are Equal and also use the same manipulator,
+// so that they hold the same bits in the same encoding. Padding bits beyond the size are ignored.
+func (bf *BitField) StrictEqual(other *BitField) bool {
+	return bf.manipulator == other.manipulator && bf.Equal(other)
+}
+
+// Compare orders the BitField and other by the unsigned integers they hold in the value order of their
+// manipulators, the same order as ToUint64 and ExtractBigInt over the whole BitField. It returns -1 if the
+// BitField is less than other, 0 if they are equal and +1 if it is greater. BitFields of different sizes are
+// compared by value first and then by size, so that of two equal values the one in the shorter BitField is less.
+func (bf *BitField) Compare(other *BitField) int {
+	for j := max(bf.size, other.size); j > 0; j-- {
+		a, b := bf.valueBit(j-1), other.valueBit(j-1)
+		if a != b {
+			if b {
+				return -1
+			}
+			return +1
+		}
+	}
+	return cmp.Compare(bf.size, other.size)
+}
+
+// valueBit returns bit j, counting from the least significant bit, of the value held by the whole BitField.
+// Bits beyond the size of the BitField are 0.
+func (bf *BitField) valueBit(j uint64) bool {
+	return j < bf.size && bf.bit(bf.valuePos(0, bf.size, j))
+}
@@ -0,0 +1,414 @@
+package bitfield
+
+import (
+	"cmp"
+	"testing"
+)
+
+// Test case structs
+
+type CompareTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Left-hand operand
+	other         *BitField // Right-hand operand
+	expectedEqual bool      // Whether the BitFields are expected to be equal
+}
+
+type OrderTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Left-hand operand
+	other         *BitField // Right-hand operand
+	expectedOrder int       // Expected result of comparing bf with other
+}
+
+// Test cases
+
+var equalTestCases = []CompareTestCase{
+	{
+		name:          "Empty BitFields",
+		bf:            LittleEndian.New(0),
+		other:         LittleEndian.New(0),
+		expectedEqual: true,
+	},
+	{
+		name: "Equal fields",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b00000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b00000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedEqual: true,
+	},
+	{
+		name: "Differing data",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b00000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b00000111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedEqual: false,
+	},
+	{
+		name: "Size mismatch with matching bytes",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001},
+			size:        7,
+			manipulator: LittleEndian,
+		},
+		expectedEqual: false,
+	},
+	{
+		name: "Differing padding bits LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000001},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b11111111, 0b10101001},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedEqual: true,
+	},
+	{
+		name: "Differing padding bits BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b10000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b11111111, 0b10010101},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: true,
+	},
+	{
+		name: "Same storage under different manipulators",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: true,
+	},
+}
+
+var equalLogicalTestCases = []CompareTestCase{
+	{
+		name:          "Empty BitFields",
+		bf:            LittleEndian.New(0),
+		other:         BigEndian.New(0),
+		expectedEqual: true,
+	},
+	{
+		name: "Same sequence under different manipulators",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b00000010}, // Positions 0 and 9 set
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10000000, 0b01000000}, // Positions 0 and 9 set
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: true,
+	},
+	{
+		name: "Same storage under different manipulators",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: false,
+	},
+	{
+		name: "Same sequence with differing padding bits",
+		bf: &BitField{
+			data:        []byte{0b11110101},
+			size:        4,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10101111},
+			size:        4,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: true,
+	},
+	{
+		name:          "Size mismatch",
+		bf:            LittleEndian.New(8),
+		other:         BigEndian.New(9),
+		expectedEqual: false,
+	},
+}
+
+var strictEqualTestCases = []CompareTestCase{
+	{
+		name:          "Empty BitFields",
+		bf:            BigEndian.New(0),
+		other:         BigEndian.New(0),
+		expectedEqual: true,
+	},
+	{
+		name: "Identical fields",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b00000011},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b10101010, 0b00000011},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: true,
+	},
+	{
+		name: "Same data and size under different manipulators",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: false,
+	},
+	{
+		name: "Same logical bits under different manipulators",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b10000000},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedEqual: false,
+	},
+	{
+		name: "Differing data",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00000011},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		expectedEqual: false,
+	},
+	{
+		name: "Differing padding bits",
+		bf: &BitField{
+			data:        []byte{0b00000101},
+			size:        4,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b11110101},
+			size:        4,
+			manipulator: LittleEndian,
+		},
+		expectedEqual: true,
+	},
+	{
+		name:          "Size mismatch",
+		bf:            LittleEndian.New(8),
+		other:         LittleEndian.New(16),
+		expectedEqual: false,
+	},
+}
+
+var compareOrderTestCases = []OrderTestCase{
+	{
+		name:          "Empty BitFields",
+		bf:            LittleEndian.New(0),
+		other:         BigEndian.New(0),
+		expectedOrder: 0,
+	},
+	{
+		name:          "Equal fields",
+		bf:            BigEndian.FromBytes([]byte{0b10110100, 0b01000000}, 10),
+		other:         BigEndian.FromBytes([]byte{0b10110100, 0b01000000}, 10),
+		expectedOrder: 0,
+	},
+	{
+		name:          "Differ only in the low bit BE",
+		bf:            BigEndian.FromBytes([]byte{0b10110100, 0b00000000}, 10),
+		other:         BigEndian.FromBytes([]byte{0b10110100, 0b01000000}, 10),
+		expectedOrder: -1,
+	},
+	{
+		name:          "Differ only in the low bit LE",
+		bf:            LittleEndian.FromBytes([]byte{0b00101101, 0b00000010}, 10),
+		other:         LittleEndian.FromBytes([]byte{0b00101100, 0b00000010}, 10),
+		expectedOrder: +1,
+	},
+	{
+		name:          "Differ only in the high bit LE",
+		bf:            LittleEndian.FromBytes([]byte{0b11111111, 0b00000001}, 10),
+		other:         LittleEndian.FromBytes([]byte{0b00000000, 0b00000010}, 10),
+		expectedOrder: -1,
+	},
+	{
+		name:          "Position 0 is most significant BE",
+		bf:            BigEndian.FromBytes([]byte{0b10000000}, 8),
+		other:         BigEndian.FromBytes([]byte{0b01111111}, 8),
+		expectedOrder: +1,
+	},
+	{
+		name:          "Position 0 is least significant LE",
+		bf:            LittleEndian.FromBytes([]byte{0b00000001}, 8),
+		other:         LittleEndian.FromBytes([]byte{0b00000010}, 8),
+		expectedOrder: -1,
+	},
+	{
+		name:          "Equal values under different manipulators",
+		bf:            BigEndian.FromBytes([]byte{0b11010000}, 4),
+		other:         LittleEndian.FromBytes([]byte{0b00001101}, 4),
+		expectedOrder: 0,
+	},
+	{
+		name:          "Padding bits are ignored",
+		bf:            LittleEndian.FromBytes([]byte{0b11110001}, 4),
+		other:         LittleEndian.FromBytes([]byte{0b00000001}, 4),
+		expectedOrder: 0,
+	},
+	{
+		name:          "Same value, shorter size is less",
+		bf:            BigEndian.FromBytes([]byte{0b10100000}, 3),
+		other:         BigEndian.FromBytes([]byte{0b01010000}, 4),
+		expectedOrder: -1,
+	},
+	{
+		name:          "Larger value in shorter field",
+		bf:            BigEndian.FromBytes([]byte{0b11000000}, 2),
+		other:         BigEndian.FromBytes([]byte{0b00000000, 0b00100000}, 12),
+		expectedOrder: +1,
+	},
+	{
+		name:          "Larger value in shorter field LE",
+		bf:            LittleEndian.FromBytes([]byte{0b00000011}, 2),
+		other:         LittleEndian.FromBytes([]byte{0b00000010, 0b00000000}, 12),
+		expectedOrder: +1,
+	},
+	{
+		name:          "Smaller value in longer field",
+		bf:            BigEndian.FromBytes([]byte{0b00000000, 0b00010000}, 12),
+		other:         BigEndian.FromBytes([]byte{0b11000000}, 2),
+		expectedOrder: -1,
+	},
+}
+
+// Test functions
+
+func TestEqual(t *testing.T) {
+	for _, tc := range equalTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if equal := tc.bf.Equal(tc.other); equal != tc.expectedEqual {
+				t.Errorf("Equal() got %t, want %t", equal, tc.expectedEqual)
+			}
+		})
+	}
+}
+
+func TestEqualLogical(t *testing.T) {
+	for _, tc := range equalLogicalTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if equal := tc.bf.EqualLogical(tc.other); equal != tc.expectedEqual {
+				t.Errorf("EqualLogical() got %t, want %t", equal, tc.expectedEqual)
+			}
+			if equal := tc.other.EqualLogical(tc.bf); equal != tc.expectedEqual {
+				t.Errorf("EqualLogical() reversed got %t, want %t", equal, tc.expectedEqual)
+			}
+		})
+	}
+}
+
+func TestStrictEqual(t *testing.T) {
+	for _, tc := range strictEqualTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if equal := tc.bf.StrictEqual(tc.other); equal != tc.expectedEqual {
+				t.Errorf("StrictEqual() got %t, want %t", equal, tc.expectedEqual)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	for _, tc := range compareOrderTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if order := tc.bf.Compare(tc.other); order != tc.expectedOrder {
+				t.Errorf("Compare() got %d, want %d", order, tc.expectedOrder)
+			}
+			if order := tc.other.Compare(tc.bf); order != -tc.expectedOrder {
+				t.Errorf("Compare() reversed got %d, want %d", order, -tc.expectedOrder)
+			}
+		})
+	}
+}
+
+func TestCompareMatchesToUint64(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for a := uint64(0); a < 16; a++ {
+			for b := uint64(0); b < 16; b++ {
+				x, y := m.New(4), m.New(4)
+				x.InsertUint64(0, 4, a)
+				y.InsertUint64(0, 4, b)
+
+				if order, expected := x.Compare(y), cmp.Compare(a, b); order != expected {
+					t.Errorf("Compare() of %d and %d got %d, want %d", a, b, order, expected)
+				}
+			}
+		}
+	}
+}
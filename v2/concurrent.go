@@ -0,0 +1,62 @@
+package bitfield
+
+import (
+	"sync"
+)
+
+// ConcurrentBitField wraps a BitField so that it can be used from multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock.
+//
+// The wrapped BitField must not be used directly once it has been passed to NewConcurrent,
+// as accesses that bypass the ConcurrentBitField are not synchronized.
+type ConcurrentBitField struct {
+	mu sync.RWMutex
+	bf *BitField // The wrapped BitField, guarded by mu.
+}
+
+// NewConcurrent returns a ConcurrentBitField that guards bf.
+func NewConcurrent(bf *BitField) *ConcurrentBitField {
+	return &ConcurrentBitField{bf: bf}
+}
+
+// SetBit sets the bit at pos to 1.
+func (c *ConcurrentBitField) SetBit(pos uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bf.SetBit(pos)
+}
+
+// ClearBit sets the bit at pos to 0.
+func (c *ConcurrentBitField) ClearBit(pos uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bf.ClearBit(pos)
+}
+
+// ToggleBit inverts the bit at pos.
+func (c *ConcurrentBitField) ToggleBit(pos uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bf.ToggleBit(pos)
+}
+
+// TestBit reports whether the bit at pos is set.
+func (c *ConcurrentBitField) TestBit(pos uint64) (bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.bf.TestBit(pos)
+}
+
+// OnesCount returns the number of bits set to 1.
+func (c *ConcurrentBitField) OnesCount() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.bf.OnesCount()
+}
+
+// Error returns the error set by the last failing bit manipulation method.
+func (c *ConcurrentBitField) Error() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.bf.Error()
+}
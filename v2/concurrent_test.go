@@ -0,0 +1,71 @@
+package bitfield
+
+import (
+	"sync"
+	"testing"
+)
+
+// Test functions
+
+func TestConcurrentBitField(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		const size = 256
+		const workers = 16
+
+		c := NewConcurrent(m.New(size))
+
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for pos := uint64(0); pos < size; pos++ {
+					// Every worker hits every byte, setting its own subset of bits
+					// and toggling a shared bit twice so that it ends up unchanged.
+					if pos%workers == uint64(w) {
+						if err := c.SetBit(pos); err != nil {
+							t.Errorf("SetBit() returned unexpected error: %v", err)
+						}
+					}
+					c.ToggleBit(0)
+					c.ToggleBit(0)
+					if _, err := c.TestBit(pos); err != nil {
+						t.Errorf("TestBit() returned unexpected error: %v", err)
+					}
+					c.OnesCount()
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		if got := c.OnesCount(); got != size {
+			t.Errorf("OnesCount() got %d, want %d", got, size)
+		}
+
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for pos := uint64(w); pos < size; pos += workers {
+					c.ClearBit(pos)
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		if got := c.OnesCount(); got != 0 {
+			t.Errorf("OnesCount() got %d, want 0", got)
+		}
+	}
+}
+
+func TestConcurrentBitFieldError(t *testing.T) {
+	c := NewConcurrent(LittleEndian.New(8))
+
+	if err := c.SetBit(8); err == nil {
+		t.Errorf("SetBit() returned no error, want out of range error")
+	}
+	if c.Error() == nil {
+		t.Errorf("Error() returned nil, want the stored error")
+	}
+}
@@ -0,0 +1,117 @@
+package bitfield
+
+import (
+	"math/bits"
+)
+
+// OnesCount returns the number of bits set to 1 in the BitField.
+func (bf *BitField) OnesCount() uint64 {
+	var count uint64
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		count += uint64(bits.OnesCount8(bf.maskedByte(i)))
+	}
+	return count
+}
+
+// OnesCountRange returns the number of bits set to 1 at the positions [offset, offset+count).
+// Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) OnesCountRange(offset, count uint64) (uint64, error) {
+	if bf.err != nil {
+		return 0, bf.err
+	}
+	if err := bf.checkRange(offset, count); err != nil {
+		return 0, err
+	}
+
+	var ones uint64
+	bf.forEachRangeByte(offset, count, func(i uint64, mask byte) {
+		ones += uint64(bits.OnesCount8(bf.data[i] & mask))
+	})
+	return ones, nil
+}
+
+// AllInRange reports whether every bit at the positions [offset, offset+count) is set to 1.
+// It returns true for an empty range. Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) AllInRange(offset, count uint64) (bool, error) {
+	if bf.err != nil {
+		return false, bf.err
+	}
+	if err := bf.checkRange(offset, count); err != nil {
+		return false, err
+	}
+
+	return bf.allRangeBytes(offset, count, func(i uint64, mask byte) bool {
+		return bf.data[i]&mask == mask
+	}), nil
+}
+
+// NoneInRange reports whether every bit at the positions [offset, offset+count) is set to 0.
+// It returns true for an empty range. Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) NoneInRange(offset, count uint64) (bool, error) {
+	if bf.err != nil {
+		return false, bf.err
+	}
+	if err := bf.checkRange(offset, count); err != nil {
+		return false, err
+	}
+
+	return bf.allRangeBytes(offset, count, func(i uint64, mask byte) bool {
+		return bf.data[i]&mask == 0
+	}), nil
+}
+
+// ZeroesCount returns the number of bits set to 0 within the size of the BitField.
+// Padding bits beyond the size are not counted.
+func (bf *BitField) ZeroesCount() uint64 {
+	return bf.size - bf.OnesCount()
+}
+
+// Any reports whether at least one bit of the BitField is set.
+func (bf *BitField) Any() bool {
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if bf.maskedByte(i) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether every bit within the size of the BitField is set.
+// It returns true for a BitField of size 0.
+func (bf *BitField) All() bool {
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if bf.maskedByte(i)|bf.paddingMaskAt(i) != 0xFF {
+			return false
+		}
+	}
+	return true
+}
+
+// None reports whether no bit of the BitField is set.
+// It returns true for a BitField of size 0.
+func (bf *BitField) None() bool {
+	return !bf.Any()
+}
+
+// LeadingZeros returns the number of consecutive bits set to 0 from the highest position of the
+// BitField down to its highest bit set to 1. It returns the size of the BitField if no bit is set.
+func (bf *BitField) LeadingZeros() uint64 {
+	for i := (bf.size + 7) / 8; i > 0; i-- {
+		if b := bf.normalize(bf.maskedByte(i - 1)); b != 0 {
+			highest := i*8 - 1 - uint64(bits.LeadingZeros8(b))
+			return bf.size - 1 - highest
+		}
+	}
+	return bf.size
+}
+
+// TrailingZeros returns the number of consecutive bits set to 0 from position 0 up to the lowest
+// bit set to 1. It returns the size of the BitField if no bit is set.
+func (bf *BitField) TrailingZeros() uint64 {
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if b := bf.normalize(bf.maskedByte(i)); b != 0 {
+			return i*8 + uint64(bits.TrailingZeros8(b))
+		}
+	}
+	return bf.size
+}
@@ -0,0 +1,660 @@
+package bitfield
+
+import (
+	"testing"
+)
+
+// Test case structs
+
+type CountTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Initial BitField for the test
+	expectedCount uint64    // Expected number of bits
+}
+
+type QueryTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	expectedAny  bool      // Expected result of Any
+	expectedAll  bool      // Expected result of All
+	expectedNone bool      // Expected result of None
+}
+
+type CountRangeTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Initial BitField for the test
+	offset        uint64    // Start of the range
+	count         uint64    // Number of bits in the range
+	expectError   bool      // Whether an error is expected
+	expectedCount uint64    // Expected number of bits set within the range
+}
+
+type RangeQueryTestCase struct {
+	name           string    // Name of the test case
+	bf             *BitField // Initial BitField for the test
+	offset         uint64    // Start of the range
+	count          uint64    // Number of bits in the range
+	expectError    bool      // Whether an error is expected
+	expectedResult bool      // Expected result of the query
+}
+
+// Test cases
+
+var onesCountTestCases = []CountTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		expectedCount: 0,
+	},
+	{
+		name: "Fully set BitField",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 16,
+	},
+	{
+		name: "Partially set BitField",
+		bf: &BitField{
+			data:        []byte{0b10100000, 0b00000001},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 3,
+	},
+	{
+		name: "12-bit field with padding bits LE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b11111000},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 2, // Only bit 11 of the second byte is within the size
+	},
+	{
+		name: "12-bit field with padding bits BE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b00011111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 2, // Only bit 11 of the second byte is within the size
+	},
+}
+
+var onesCountRangeLE = &BitField{
+	data:        []byte{0b00000111, 0b11111111, 0b00000111},
+	size:        24,
+	manipulator: LittleEndian,
+}
+
+var onesCountRangeBE = &BitField{
+	data:        []byte{0b00000111, 0b11111111, 0b00000111},
+	size:        24,
+	manipulator: BigEndian,
+	msb0:        true,
+}
+
+var onesCountRangeTestCases = []CountRangeTestCase{
+	{
+		name:          "Within a single byte LE",
+		bf:            onesCountRangeLE,
+		offset:        1,
+		count:         3,
+		expectedCount: 2, // Positions 1 and 2
+	},
+	{
+		name:          "Within a single byte BE",
+		bf:            onesCountRangeBE,
+		offset:        1,
+		count:         3,
+		expectedCount: 0,
+	},
+	{
+		name:          "Start and end mid-byte LE",
+		bf:            onesCountRangeLE,
+		offset:        2,
+		count:         16,
+		expectedCount: 11, // Positions 2, 8 to 15, 16 and 17
+	},
+	{
+		name:          "Start and end mid-byte BE",
+		bf:            onesCountRangeBE,
+		offset:        6,
+		count:         16,
+		expectedCount: 11, // Positions 6, 7, 8 to 15 and 21
+	},
+	{
+		name:          "Whole field",
+		bf:            onesCountRangeBE,
+		offset:        0,
+		count:         24,
+		expectedCount: 14,
+	},
+	{
+		name:          "Empty range at the end",
+		bf:            onesCountRangeLE,
+		offset:        24,
+		count:         0,
+		expectedCount: 0,
+	},
+	{
+		name: "Padding bits excluded",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11111111},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		offset:        8,
+		count:         4,
+		expectedCount: 4,
+	},
+	{
+		name:        "Range out of bounds",
+		bf:          onesCountRangeLE,
+		offset:      20,
+		count:       5,
+		expectError: true,
+	},
+}
+
+var zeroesCountTestCases = []CountTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		expectedCount: 0,
+	},
+	{
+		name:          "Cleared byte-aligned field",
+		bf:            LittleEndian.New(16),
+		expectedCount: 16,
+	},
+	{
+		name: "Byte-aligned field",
+		bf: &BitField{
+			data:        []byte{0b10110000, 0b00000001},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 12,
+	},
+	{
+		name:          "Cleared non-aligned field",
+		bf:            BigEndian.New(10),
+		expectedCount: 10, // Padding bits are not counted as zeroes
+	},
+	{
+		name: "Non-aligned field with padding bits LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111101},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 1,
+	},
+	{
+		name: "Non-aligned field with padding bits BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b10111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 1,
+	},
+}
+
+var queryTestCases = []QueryTestCase{
+	{
+		name:         "Zero-size BitField",
+		bf:           LittleEndian.New(0),
+		expectedAny:  false,
+		expectedAll:  true,
+		expectedNone: true,
+	},
+	{
+		name:         "Cleared BitField",
+		bf:           LittleEndian.New(16),
+		expectedAny:  false,
+		expectedAll:  false,
+		expectedNone: true,
+	},
+	{
+		name: "Partially set BitField",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00010000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedAny:  true,
+		expectedAll:  false,
+		expectedNone: false,
+	},
+	{
+		name: "Fully set BitField",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedAny:  true,
+		expectedAll:  true,
+		expectedNone: false,
+	},
+	{
+		name: "10-bit field with low 10 bits set LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000011},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedAny:  true,
+		expectedAll:  true,
+		expectedNone: false,
+	},
+	{
+		name: "10-bit field with first 10 bits set BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedAny:  true,
+		expectedAll:  true,
+		expectedNone: false,
+	},
+	{
+		name: "Only padding bits set",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11111100},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedAny:  false,
+		expectedAll:  false,
+		expectedNone: true,
+	},
+}
+
+var leadingZerosTestCases = []CountTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		expectedCount: 0,
+	},
+	{
+		name:          "All-zero non-aligned field",
+		bf:            BigEndian.New(10),
+		expectedCount: 10,
+	},
+	{
+		name: "Highest position set LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000010},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 0,
+	},
+	{
+		name: "Highest position set BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b01000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 0,
+	},
+	{
+		name: "Set bit in first byte LE",
+		bf: &BitField{
+			data:        []byte{0b00001001, 0b11111100},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 6, // Position 3 is the highest set bit, padding bits are ignored
+	},
+	{
+		name: "Set bit in first byte BE",
+		bf: &BitField{
+			data:        []byte{0b10010000, 0b00111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 6, // Position 3 is the highest set bit, padding bits are ignored
+	},
+	{
+		name: "Byte-aligned field",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000001, 0b00000000},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 15,
+	},
+}
+
+var trailingZerosTestCases = []CountTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            BigEndian.New(0),
+		expectedCount: 0,
+	},
+	{
+		name:          "All-zero field LE",
+		bf:            LittleEndian.New(12),
+		expectedCount: 12,
+	},
+	{
+		name: "All-zero field with padding bits BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00001111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 12,
+	},
+	{
+		name: "First position set",
+		bf: &BitField{
+			data:        []byte{0b00000001},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 0,
+	},
+	{
+		name: "Set bit in second byte LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00100100},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedCount: 10,
+	},
+	{
+		name: "Set bit in second byte BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00100100},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedCount: 10,
+	},
+}
+
+// rangeQueryLE and rangeQueryBE have the positions [3, 21) set, with ragged edges in the first and third bytes.
+var rangeQueryLE = &BitField{
+	data:        []byte{0b11111000, 0b11111111, 0b00011111, 0b00000000},
+	size:        32,
+	manipulator: LittleEndian,
+}
+
+var rangeQueryBE = &BitField{
+	data:        []byte{0b00011111, 0b11111111, 0b11111000, 0b00000000},
+	size:        32,
+	manipulator: BigEndian,
+	msb0:        true,
+}
+
+var allInRangeTestCases = []RangeQueryTestCase{
+	{
+		name:           "Whole set region LE",
+		bf:             rangeQueryLE,
+		offset:         3,
+		count:          18,
+		expectedResult: true,
+	},
+	{
+		name:           "Whole set region BE",
+		bf:             rangeQueryBE,
+		offset:         3,
+		count:          18,
+		expectedResult: true,
+	},
+	{
+		name:           "Crossing byte boundaries inside the region",
+		bf:             rangeQueryLE,
+		offset:         6,
+		count:          12,
+		expectedResult: true,
+	},
+	{
+		name:           "Including the clear bit before the region LE",
+		bf:             rangeQueryLE,
+		offset:         2,
+		count:          19,
+		expectedResult: false,
+	},
+	{
+		name:           "Including the clear bit after the region BE",
+		bf:             rangeQueryBE,
+		offset:         3,
+		count:          19,
+		expectedResult: false,
+	},
+	{
+		name:           "Whole byte inside the region",
+		bf:             rangeQueryBE,
+		offset:         8,
+		count:          8,
+		expectedResult: true,
+	},
+	{
+		name:           "Clear byte",
+		bf:             rangeQueryLE,
+		offset:         24,
+		count:          8,
+		expectedResult: false,
+	},
+	{
+		name:           "Empty range",
+		bf:             rangeQueryLE,
+		offset:         32,
+		count:          0,
+		expectedResult: true,
+	},
+	{
+		name: "Padding bits ignored",
+		bf: &BitField{
+			data:        []byte{0b11110000, 0b00001111},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		offset:         4,
+		count:          8,
+		expectedResult: true,
+	},
+	{
+		name:        "Range beyond size",
+		bf:          rangeQueryLE,
+		offset:      30,
+		count:       3,
+		expectError: true,
+	},
+}
+
+var noneInRangeTestCases = []RangeQueryTestCase{
+	{
+		name:           "Fully clear range before the region LE",
+		bf:             rangeQueryLE,
+		offset:         0,
+		count:          3,
+		expectedResult: true,
+	},
+	{
+		name:           "Fully clear range after the region BE",
+		bf:             rangeQueryBE,
+		offset:         21,
+		count:          11,
+		expectedResult: true,
+	},
+	{
+		name:           "Partially occupied at the start LE",
+		bf:             rangeQueryLE,
+		offset:         0,
+		count:          4,
+		expectedResult: false,
+	},
+	{
+		name:           "Partially occupied at the end BE",
+		bf:             rangeQueryBE,
+		offset:         20,
+		count:          12,
+		expectedResult: false,
+	},
+	{
+		name:           "Fully occupied range",
+		bf:             rangeQueryLE,
+		offset:         8,
+		count:          8,
+		expectedResult: false,
+	},
+	{
+		name:           "Empty range",
+		bf:             rangeQueryBE,
+		offset:         10,
+		count:          0,
+		expectedResult: true,
+	},
+	{
+		name: "Padding bits ignored",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00001111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:         0,
+		count:          12,
+		expectedResult: true,
+	},
+	{
+		name:        "Range beyond size",
+		bf:          rangeQueryBE,
+		offset:      33,
+		count:       0,
+		expectError: true,
+	},
+}
+
+// Test functions
+
+func TestOnesCount(t *testing.T) {
+	for _, tc := range onesCountTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if count := tc.bf.OnesCount(); count != tc.expectedCount {
+				t.Errorf("OnesCount() got %d, want %d", count, tc.expectedCount)
+			}
+		})
+	}
+}
+
+func TestOnesCountRange(t *testing.T) {
+	for _, tc := range onesCountRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			count, err := tc.bf.OnesCountRange(tc.offset, tc.count)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("OnesCountRange() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if count != tc.expectedCount {
+				t.Errorf("OnesCountRange() got %d, want %d", count, tc.expectedCount)
+			}
+		})
+	}
+}
+
+func runRangeQueryTest(t *testing.T, name string, query func(bf *BitField, offset, count uint64) (bool, error), tc RangeQueryTestCase) {
+	result, err := query(tc.bf, tc.offset, tc.count)
+
+	if (err != nil) != tc.expectError {
+		t.Errorf("%s() returned unexpected error: got %v, want %v", name, err, tc.expectError)
+		return
+	}
+
+	if result != tc.expectedResult {
+		t.Errorf("%s() got %t, want %t", name, result, tc.expectedResult)
+	}
+}
+
+func TestAllInRange(t *testing.T) {
+	for _, tc := range allInRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRangeQueryTest(t, "AllInRange", (*BitField).AllInRange, tc)
+		})
+	}
+}
+
+func TestNoneInRange(t *testing.T) {
+	for _, tc := range noneInRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRangeQueryTest(t, "NoneInRange", (*BitField).NoneInRange, tc)
+		})
+	}
+}
+
+func TestZeroesCount(t *testing.T) {
+	for _, tc := range zeroesCountTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if count := tc.bf.ZeroesCount(); count != tc.expectedCount {
+				t.Errorf("ZeroesCount() got %d, want %d", count, tc.expectedCount)
+			}
+		})
+	}
+}
+
+func TestQueries(t *testing.T) {
+	for _, tc := range queryTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if value := tc.bf.Any(); value != tc.expectedAny {
+				t.Errorf("Any() got %t, want %t", value, tc.expectedAny)
+			}
+			if value := tc.bf.All(); value != tc.expectedAll {
+				t.Errorf("All() got %t, want %t", value, tc.expectedAll)
+			}
+			if value := tc.bf.None(); value != tc.expectedNone {
+				t.Errorf("None() got %t, want %t", value, tc.expectedNone)
+			}
+		})
+	}
+}
+
+func TestLeadingZeros(t *testing.T) {
+	for _, tc := range leadingZerosTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if count := tc.bf.LeadingZeros(); count != tc.expectedCount {
+				t.Errorf("LeadingZeros() got %d, want %d", count, tc.expectedCount)
+			}
+		})
+	}
+}
+
+func TestTrailingZeros(t *testing.T) {
+	for _, tc := range trailingZerosTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			count := tc.bf.TrailingZeros()
+			if count != tc.expectedCount {
+				t.Errorf("TrailingZeros() got %d, want %d", count, tc.expectedCount)
+			}
+
+			// When a bit is set, TrailingZeros must agree with FindFirstSet
+			if pos, found := tc.bf.FindFirstSet(); found && pos != count {
+				t.Errorf("TrailingZeros() got %d, FindFirstSet() got %d", count, pos)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package bitfield
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding consists of the size of the
+// BitField as an unsigned varint, followed by the underlying data with the padding bits cleared.
+// The manipulator is not encoded.
+func (bf *BitField) MarshalBinary() ([]byte, error) {
+	data := binary.AppendUvarint(nil, bf.size)
+	return append(data, bf.canonical()...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler by decoding the output of MarshalBinary.
+// Since the manipulator is not part of the encoding, the BitField keeps its current manipulator,
+// defaulting to LittleEndian for a zero BitField. Use UnmarshalBinaryWith to choose the manipulator.
+func (bf *BitField) UnmarshalBinary(data []byte) error {
+	m := bf.manipulator
+	if m == nil {
+		m = LittleEndian
+	}
+	return bf.UnmarshalBinaryWith(data, m)
+}
+
+// UnmarshalBinaryWith decodes the output of MarshalBinary into the BitField using the manipulator m.
+// The sticky error of the BitField is cleared.
+func (bf *BitField) UnmarshalBinaryWith(data []byte, m BitManipulator) error {
+	size, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("%w: malformed size", ErrInvalidEncoding)
+	}
+	// The size is checked against the data first, so that the byte count cannot wrap around.
+	if size > uint64(len(data)-n)*8 || uint64(len(data)-n) != byteCount(size) {
+		return fmt.Errorf("%w: %d data bytes for size %d", ErrInvalidEncoding, len(data)-n, size)
+	}
+
+	bf.data = allocData(uint64(len(data)-n), uint64(len(data)-n))
+	copy(bf.data, data[n:])
+	bf.size = size
+	bf.manipulator = m
+	bf.msb0 = msb0(m)
+	bf.err = nil
+	return nil
+}
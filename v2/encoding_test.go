@@ -0,0 +1,127 @@
+package bitfield
+
+import (
+	"encoding"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Compile-time check to ensure BitField implements the binary encoding interfaces
+var _ encoding.BinaryMarshaler = &BitField{}
+var _ encoding.BinaryUnmarshaler = &BitField{}
+
+// Test case structs
+
+type UnmarshalBinaryTestCase struct {
+	name        string // Name of the test case
+	data        []byte // The encoded BitField
+	expectError bool   // Whether an error is expected
+}
+
+// Test cases
+
+var unmarshalBinaryTestCases = []UnmarshalBinaryTestCase{
+	{
+		name: "Empty BitField",
+		data: []byte{0x00},
+	},
+	{
+		name: "Non-aligned size",
+		data: []byte{0x0A, 0xFF, 0x03},
+	},
+	{
+		name:        "Missing size",
+		data:        []byte{},
+		expectError: true,
+	},
+	{
+		name:        "Truncated size",
+		data:        []byte{0x80},
+		expectError: true,
+	},
+	{
+		name:        "Too few data bytes",
+		data:        []byte{0x0A, 0xFF},
+		expectError: true,
+	},
+	{
+		name:        "Size whose byte count would wrap around",
+		data:        binary.AppendUvarint(nil, math.MaxUint64),
+		expectError: true,
+	},
+	{
+		name:        "Too many data bytes",
+		data:        []byte{0x08, 0xFF, 0x00},
+		expectError: true,
+	},
+}
+
+// Test functions
+
+func TestMarshalBinary(t *testing.T) {
+	bf := LittleEndian.FromBytes([]byte{0b11111111, 0b11111101}, 10)
+	data, err := bf.MarshalBinary()
+	if err != nil {
+		t.Errorf("MarshalBinary() returned unexpected error: %v", err)
+	}
+
+	// The padding bits are cleared in the encoding
+	if expected := []byte{0x0A, 0b11111111, 0b00000001}; !reflect.DeepEqual(data, expected) {
+		t.Errorf("MarshalBinary() got %v, want %v", data, expected)
+	}
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	for _, tc := range unmarshalBinaryTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var bf BitField
+			err := bf.UnmarshalBinary(tc.data)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("UnmarshalBinary() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if !tc.expectError && bf.manipulator != LittleEndian {
+				t.Errorf("UnmarshalBinary() got manipulator %v, want %v", bf.manipulator, LittleEndian)
+			}
+		})
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for _, size := range []uint64{0, 1, 8, 13, 200} {
+			bf := m.New(size)
+			for pos := uint64(0); pos < size; pos += 3 {
+				bf.SetBit(pos)
+			}
+
+			data, _ := bf.MarshalBinary()
+			decoded := &BitField{}
+			if err := decoded.UnmarshalBinaryWith(data, m); err != nil {
+				t.Errorf("UnmarshalBinaryWith() returned unexpected error: %v", err)
+				continue
+			}
+
+			if decoded.size != bf.size || !reflect.DeepEqual(decoded.data, bf.data) || decoded.manipulator != m {
+				t.Errorf("binary round trip got (%d, %v), want (%d, %v)", decoded.size, decoded.data, bf.size, bf.data)
+			}
+		}
+	}
+}
+
+func TestUnmarshalBinaryKeepsManipulator(t *testing.T) {
+	bf := BigEndian.New(0)
+	if err := bf.UnmarshalBinary([]byte{0x03, 0b10100000}); err != nil {
+		t.Errorf("UnmarshalBinary() returned unexpected error: %v", err)
+	}
+	if bf.manipulator != BigEndian {
+		t.Errorf("UnmarshalBinary() got manipulator %v, want %v", bf.manipulator, BigEndian)
+	}
+	if bit, _ := bf.TestBit(0); !bit {
+		t.Errorf("TestBit(0) got false, want true")
+	}
+}
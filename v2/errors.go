@@ -0,0 +1,30 @@
+package bitfield
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrOutOfRange is returned when a bit position or range lies beyond the size of a BitField.
+	ErrOutOfRange = errors.New("bit position out of range")
+
+	// ErrInvalidSize is returned when the number of bits of an operation is invalid.
+	ErrInvalidSize = errors.New("size is invalid")
+
+	// ErrSizeTooLarge is returned when the size of an operation on a uint64 value is greater than 64 bits.
+	// It wraps ErrInvalidSize, so errors.Is matches both.
+	ErrSizeTooLarge = fmt.Errorf("%w: more than 64 bits", ErrInvalidSize)
+
+	// ErrInvalidValue is returned when a value cannot be stored, such as a negative big.Int.
+	ErrInvalidValue = errors.New("value is invalid")
+
+	// ErrSizeMismatch is returned when two BitFields that must have the same size differ in size.
+	ErrSizeMismatch = errors.New("bit field sizes differ")
+
+	// ErrManipulatorMismatch is returned when two BitFields that must have the same manipulator differ in manipulator.
+	ErrManipulatorMismatch = errors.New("bit field manipulators differ")
+
+	// ErrInvalidEncoding is returned when decoding a BitField from malformed data.
+	ErrInvalidEncoding = errors.New("invalid encoding")
+)
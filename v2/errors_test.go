@@ -0,0 +1,152 @@
+package bitfield
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Test case structs
+
+type SentinelErrorTestCase struct {
+	name   string       // Name of the test case
+	op     func() error // Operation expected to fail
+	target error        // Sentinel error the failure must match
+}
+
+// Test cases
+
+var sentinelErrorTestCases = []SentinelErrorTestCase{
+	{
+		name:   "SetBit out of range LE",
+		op:     func() error { return LittleEndian.New(8).SetBit(8) },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "TestBit out of range BE",
+		op:     func() error { _, err := BigEndian.New(8).TestBit(9); return err },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "InsertUint64 offset out of range LE",
+		op:     func() error { return LittleEndian.New(16).InsertUint64(12, 8, 0) },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "InsertUint64 size too large BE",
+		op:     func() error { return BigEndian.New(128).InsertUint64(0, 65, 0) },
+		target: ErrInvalidSize,
+	},
+	{
+		name:   "ExtractUint64 offset out of range BE",
+		op:     func() error { _, err := BigEndian.New(16).ExtractUint64(10, 8); return err },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "ExtractUint64 size too large LE",
+		op:     func() error { _, err := LittleEndian.New(128).ExtractUint64(0, 65); return err },
+		target: ErrInvalidSize,
+	},
+	{
+		name:   "Zero-size InsertUint64 beyond size",
+		op:     func() error { return LittleEndian.New(16).InsertUint64(17, 0, 0) },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "InsertUint64 size of 65 LE",
+		op:     func() error { return LittleEndian.New(128).InsertUint64(0, 65, 0) },
+		target: ErrSizeTooLarge,
+	},
+	{
+		name:   "ExtractUint64 size of 65 BE",
+		op:     func() error { _, err := BigEndian.New(128).ExtractUint64(0, 65); return err },
+		target: ErrSizeTooLarge,
+	},
+	{
+		name:   "ReadBits size of 65",
+		op:     func() error { _, err := NewBitReader(LittleEndian.New(128)).ReadBits(65); return err },
+		target: ErrSizeTooLarge,
+	},
+	{
+		name:   "WriteBits size of 65",
+		op:     func() error { return NewBitWriter(BigEndian.New(0)).WriteBits(0, 65) },
+		target: ErrSizeTooLarge,
+	},
+	{
+		name:   "InsertUint64 offset near MaxUint64 LE",
+		op:     func() error { return LittleEndian.New(16).InsertUint64(math.MaxUint64-3, 8, 0) },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "ExtractUint64 offset near MaxUint64 BE",
+		op:     func() error { _, err := BigEndian.New(16).ExtractUint64(math.MaxUint64-3, 8); return err },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "SetRange count near MaxUint64",
+		op:     func() error { return BigEndian.New(16).SetRange(2, math.MaxUint64-1) },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "Slice offset and count near MaxUint64",
+		op:     func() error { _, err := LittleEndian.New(16).Slice(math.MaxUint64, math.MaxUint64); return err },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "SetRange out of range",
+		op:     func() error { return LittleEndian.New(8).SetRange(4, 5) },
+		target: ErrOutOfRange,
+	},
+	{
+		name:   "Negative big.Int",
+		op:     func() error { return LittleEndian.New(8).InsertBigInt(0, 8, big.NewInt(-1)) },
+		target: ErrInvalidValue,
+	},
+	{
+		name:   "Mismatched sizes",
+		op:     func() error { _, err := LittleEndian.New(8).And(LittleEndian.New(9)); return err },
+		target: ErrSizeMismatch,
+	},
+	{
+		name:   "Mismatched manipulators",
+		op:     func() error { _, err := LittleEndian.New(8).Or(BigEndian.New(8)); return err },
+		target: ErrManipulatorMismatch,
+	},
+	{
+		name:   "Truncated encoding",
+		op:     func() error { return LittleEndian.New(0).UnmarshalBinary([]byte{16, 0xFF}) },
+		target: ErrInvalidEncoding,
+	},
+}
+
+// Test functions
+
+func TestSentinelErrors(t *testing.T) {
+	for _, tc := range sentinelErrorTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.op()
+			if !errors.Is(err, tc.target) {
+				t.Errorf("got error %v, want %v", err, tc.target)
+			}
+		})
+	}
+}
+
+func TestOversizedSizeDistinctFromOutOfRange(t *testing.T) {
+	err := LittleEndian.New(8).InsertUint64(0, 65, 0)
+	if !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidSize)
+	}
+	if errors.Is(err, ErrOutOfRange) {
+		t.Errorf("error %v unexpectedly matches %v", err, ErrOutOfRange)
+	}
+
+	err = LittleEndian.New(8).InsertUint64(4, 8, 0)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("got error %v, want %v", err, ErrOutOfRange)
+	}
+	if errors.Is(err, ErrSizeTooLarge) {
+		t.Errorf("error %v unexpectedly matches %v", err, ErrSizeTooLarge)
+	}
+}
@@ -0,0 +1,58 @@
+package bitfield
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// String returns the bits of the BitField from position 0 to size-1 as a string of 0s and 1s,
+// with every group of 8 positions separated by a space, e.g. "00010010 00110100".
+func (bf *BitField) String() string {
+	return bf.bitString(true)
+}
+
+// bitString returns the bits of the BitField from position 0 to size-1 as a string of 0s and 1s,
+// with every group of 8 positions separated by a space if grouped is true.
+func (bf *BitField) bitString(grouped bool) string {
+	var sb strings.Builder
+	sb.Grow(int(bf.size + bf.size/8))
+	for pos := uint64(0); pos < bf.size; pos++ {
+		if grouped && pos > 0 && pos%8 == 0 {
+			sb.WriteByte(' ')
+		}
+		if bf.bit(pos) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// Hex returns the lowercase hexadecimal encoding of the underlying data of the BitField.
+// The padding bits are cleared first, so BitFields with equal bits always produce the same encoding.
+func (bf *BitField) Hex() string {
+	return hex.EncodeToString(bf.canonical())
+}
+
+// Format implements fmt.Formatter. The verbs %v and %s print String, %b prints the bits from position 0
+// to size-1 without spaces, and %x and %X print Hex in lower and upper case. Width, precision and the '-'
+// flag apply to the resulting string as they do for %s.
+func (bf *BitField) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 'v', 's':
+		s = bf.String()
+	case 'b':
+		s = bf.bitString(false)
+	case 'x':
+		s = bf.Hex()
+	case 'X':
+		s = strings.ToUpper(bf.Hex())
+	default:
+		fmt.Fprintf(f, "%%!%c(*bitfield.BitField=%s)", verb, bf.String())
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, 's'), s)
+}
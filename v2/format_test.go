@@ -0,0 +1,206 @@
+package bitfield
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Test case structs
+
+type StringTestCase struct {
+	name           string    // Name of the test case
+	bf             *BitField // Initial BitField for the test
+	expectedString string    // Expected string representation
+}
+
+type FormatTestCase struct {
+	name           string    // Name of the test case
+	format         string    // Format string passed to fmt.Sprintf
+	bf             *BitField // BitField to format
+	expectedString string    // Expected formatted output
+}
+
+// Test cases
+
+var stringTestCases = []StringTestCase{
+	{
+		name:           "Empty BitField",
+		bf:             LittleEndian.New(0),
+		expectedString: "",
+	},
+	{
+		name: "0x1234 LE",
+		bf: &BitField{
+			data:        []byte{0x34, 0x12},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedString: "00101100 01001000",
+	},
+	{
+		name: "0x1234 BE",
+		bf: &BitField{
+			data:        []byte{0x12, 0x34},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedString: "00010010 00110100",
+	},
+	{
+		name: "10-bit field LE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b11111110},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedString: "10000000 01", // Padding bits are not rendered
+	},
+	{
+		name: "10-bit field BE",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b01111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedString: "10000000 01", // Padding bits are not rendered
+	},
+}
+
+var formatFieldLE = &BitField{
+	data:        []byte{0x34, 0xF2},
+	size:        12,
+	manipulator: LittleEndian,
+}
+
+var formatFieldBE = &BitField{
+	data:        []byte{0x12, 0x3F},
+	size:        12,
+	manipulator: BigEndian,
+	msb0:        true,
+}
+
+var formatTestCases = []FormatTestCase{
+	{
+		name:           "Bits LE",
+		format:         "%b",
+		bf:             formatFieldLE,
+		expectedString: "001011000100", // Padding bits are not rendered
+	},
+	{
+		name:           "Bits BE",
+		format:         "%b",
+		bf:             formatFieldBE,
+		expectedString: "000100100011",
+	},
+	{
+		name:           "Lowercase hex LE",
+		format:         "%x",
+		bf:             formatFieldLE,
+		expectedString: "3402", // Padding bits are cleared
+	},
+	{
+		name:           "Uppercase hex BE",
+		format:         "%X",
+		bf:             &BitField{data: []byte{0xAB, 0xCF}, size: 12, manipulator: BigEndian, msb0: true},
+		expectedString: "ABC0",
+	},
+	{
+		name:           "Grouped bits",
+		format:         "%s",
+		bf:             formatFieldBE,
+		expectedString: "00010010 0011",
+	},
+	{
+		name:           "Width pads on the left",
+		format:         "%6x",
+		bf:             formatFieldLE,
+		expectedString: "  3402",
+	},
+	{
+		name:           "Minus flag pads on the right",
+		format:         "%-6x|",
+		bf:             formatFieldLE,
+		expectedString: "3402  |",
+	},
+	{
+		name:           "Precision limits the bits",
+		format:         "%.4b",
+		bf:             formatFieldBE,
+		expectedString: "0001",
+	},
+	{
+		name:           "Empty BitField",
+		format:         "[%b]",
+		bf:             LittleEndian.New(0),
+		expectedString: "[]",
+	},
+	{
+		name:           "Unsupported verb",
+		format:         "%d",
+		bf:             BigEndian.FromBytes([]byte{0b10100000}, 3),
+		expectedString: "%!d(*bitfield.BitField=101)",
+	},
+}
+
+// Test functions
+
+func TestString(t *testing.T) {
+	for _, tc := range stringTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if s := tc.bf.String(); s != tc.expectedString {
+				t.Errorf("String() got %q, want %q", s, tc.expectedString)
+			}
+
+			// The printed order must match TestBit
+			for pos := uint64(0); pos < tc.bf.size; pos++ {
+				bit, _ := tc.bf.TestBit(pos)
+				if c := tc.expectedString[pos+pos/8]; (c == '1') != bit {
+					t.Errorf("String() position %d got %c, TestBit() got %t", pos, c, bit)
+				}
+			}
+		})
+	}
+}
+
+func TestStringer(t *testing.T) {
+	bf := BigEndian.FromBytes([]byte{0b10100000}, 3)
+	if s := fmt.Sprintf("%v", bf); s != "101" {
+		t.Errorf("fmt.Sprintf(%%v) got %q, want %q", s, "101")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, tc := range formatTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if s := fmt.Sprintf(tc.format, tc.bf); s != tc.expectedString {
+				t.Errorf("fmt.Sprintf(%q) got %q, want %q", tc.format, s, tc.expectedString)
+			}
+		})
+	}
+}
+
+func TestHex(t *testing.T) {
+	clean := LittleEndian.FromBytes([]byte{0xAB, 0x01}, 12)
+	dirty := LittleEndian.FromBytes([]byte{0xAB, 0xF1}, 12)
+	if clean.Hex() != "ab01" {
+		t.Errorf("Hex() got %q, want %q", clean.Hex(), "ab01")
+	}
+	if dirty.Hex() != clean.Hex() {
+		t.Errorf("Hex() with dirty padding got %q, want %q", dirty.Hex(), clean.Hex())
+	}
+
+	cleanBE := BigEndian.FromBytes([]byte{0xAB, 0x10}, 12)
+	dirtyBE := BigEndian.FromBytes([]byte{0xAB, 0x1F}, 12)
+	if cleanBE.Hex() != "ab10" {
+		t.Errorf("Hex() got %q, want %q", cleanBE.Hex(), "ab10")
+	}
+	if dirtyBE.Hex() != cleanBE.Hex() {
+		t.Errorf("Hex() with dirty padding got %q, want %q", dirtyBE.Hex(), cleanBE.Hex())
+	}
+
+	if empty := LittleEndian.New(0).Hex(); empty != "" {
+		t.Errorf("Hex() got %q, want %q", empty, "")
+	}
+}
@@ -1,3 +1,3 @@
 module go.loafoe.dev/bitfield/v2
 
-go 1.21.5
+go 1.23
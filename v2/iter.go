@@ -0,0 +1,21 @@
+package bitfield
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// SetBits returns an iterator over the positions of the bits set to 1 in the BitField, in ascending order.
+// Padding bits beyond the size of the BitField are never yielded.
+func (bf *BitField) SetBits() iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		for i := uint64(0); i < (bf.size+7)/8; i++ {
+			// In normalized form bit j holds in-byte position j, so positions are found from the lowest bit up.
+			for b := bf.normalize(bf.maskedByte(i)); b != 0; b &= b - 1 {
+				if !yield(i*8 + uint64(bits.TrailingZeros8(b))) {
+					return
+				}
+			}
+		}
+	}
+}
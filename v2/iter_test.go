@@ -0,0 +1,98 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type SetBitsTestCase struct {
+	name string    // Name of the test case
+	bf   *BitField // BitField to iterate over
+}
+
+// Test cases
+
+var setBitsTestCases = []SetBitsTestCase{
+	{
+		name: "Empty BitField",
+		bf:   LittleEndian.New(0),
+	},
+	{
+		name: "No bits set",
+		bf:   BigEndian.New(20),
+	},
+	{
+		name: "Mixed bits LE",
+		bf: &BitField{
+			data:        []byte{0b10010110, 0b00000001, 0b10000000},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+	},
+	{
+		name: "Mixed bits BE",
+		bf: &BitField{
+			data:        []byte{0b10010110, 0b00000001, 0b10000000},
+			size:        24,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+	},
+	{
+		name: "Padding bits skipped LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111101},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+	},
+	{
+		name: "Padding bits skipped BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b10111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+	},
+}
+
+// Test functions
+
+func TestSetBits(t *testing.T) {
+	for _, tc := range setBitsTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got, expected []uint64
+			for pos := range tc.bf.SetBits() {
+				got = append(got, pos)
+			}
+			for pos := uint64(0); pos < tc.bf.Size(); pos++ {
+				if set, _ := tc.bf.TestBit(pos); set {
+					expected = append(expected, pos)
+				}
+			}
+
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("SetBits() got %v, want %v", got, expected)
+			}
+		})
+	}
+}
+
+func TestSetBitsBreak(t *testing.T) {
+	bf := LittleEndian.FromBytes([]byte{0b11111111}, 8)
+
+	var got []uint64
+	for pos := range bf.SetBits() {
+		if pos == 3 {
+			break
+		}
+		got = append(got, pos)
+	}
+
+	if expected := []uint64{0, 1, 2}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("SetBits() got %v, want %v", got, expected)
+	}
+}
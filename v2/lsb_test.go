@@ -251,6 +251,30 @@ var insertUint64TestCasesLE = []InsertUint64TestCase{
 		value:        0b1,
 		expectedBits: []byte{0b00000000, 0b00000000},
 	},
+	{
+		name: "Insert zero size at end",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		offset:       16,
+		size:         0,
+		value:        0b1,
+		expectedBits: []byte{0b00000000, 0b00000000}, // Nothing is written at offset == size
+	},
+	{
+		name: "Insert zero size beyond end",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		offset:      17,
+		size:        0,
+		value:       0b1,
+		expectError: true, // Only offsets up to the size are valid, even when nothing is written
+	},
 	{
 		name: "Insert at offset",
 		bf: &BitField{
@@ -413,6 +437,24 @@ func TestNewLE(t *testing.T) {
 	}
 }
 
+func TestNewWithCapacityLE(t *testing.T) {
+	for _, tc := range newWithCapacityTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bf := NewWithCapacity(tc.n, tc.capacityBits, LittleEndian)
+			if len(bf.data) != tc.expectedLen || cap(bf.data) != tc.expectedCapacity {
+				t.Errorf("%s: expected byte length %d and capacity %d, got %d and %d",
+					tc.name, tc.expectedLen, tc.expectedCapacity, len(bf.data), cap(bf.data))
+			}
+			if bf.size != tc.n {
+				t.Errorf("%s: expected size %d, got %d", tc.name, tc.n, bf.size)
+			}
+			if !bf.StrictEqual(LittleEndian.New(tc.n)) {
+				t.Errorf("%s: expected a cleared BitField, got %v", tc.name, bf)
+			}
+		})
+	}
+}
+
 func TestFromBytesLE(t *testing.T) {
 	for _, tc := range fromBytesTestCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -420,8 +462,8 @@ func TestFromBytesLE(t *testing.T) {
 			if bf.size != tc.size {
 				t.Errorf("%s: expected size %d, got %d", tc.name, tc.size, bf.size)
 			}
-			if !reflect.DeepEqual(bf.data, tc.bytes) {
-				t.Errorf("FromBytes() got %v, want %v", bf.data, tc.bytes)
+			if !reflect.DeepEqual(bf.data, tc.expectedBits) {
+				t.Errorf("FromBytes() got %v, want %v", bf.data, tc.expectedBits)
 			}
 			if !reflect.DeepEqual(bf.manipulator, LittleEndian) {
 				t.Errorf("%s: expected manipulator %v, got %v", tc.name, LittleEndian, bf.manipulator)
@@ -430,6 +472,19 @@ func TestFromBytesLE(t *testing.T) {
 	}
 }
 
+func TestPhysicalPositionLE(t *testing.T) {
+	bf := LittleEndian.New(24)
+	for pos := uint64(0); pos < bf.Size(); pos++ {
+		expectedByte, expectedBit, err := calcBitPosLE(bf, pos)
+		if err != nil {
+			t.Fatalf("calcBitPosLE() returned unexpected error: %v", err)
+		}
+		if bytePos, bitInByte := PhysicalPosition(pos, LittleEndian); bytePos != expectedByte || bitInByte != expectedBit {
+			t.Errorf("PhysicalPosition(%d) got %d, %d, want %d, %d", pos, bytePos, bitInByte, expectedByte, expectedBit)
+		}
+	}
+}
+
 func TestSetBitLE(t *testing.T) {
 	for _, tc := range setBitTestCasesLE {
 		t.Run(tc.name, func(t *testing.T) {
@@ -518,6 +573,61 @@ func TestInsertUint64LE(t *testing.T) {
 	}
 }
 
+func TestInsertUint64RollbackLE(t *testing.T) {
+	// The mock fails on the third bit written, after two bits have already been changed.
+	writes := 0
+	failOnThird := func(write func(bf *BitField, pos uint64) error) func(bf *BitField, pos uint64) error {
+		return func(bf *BitField, pos uint64) error {
+			writes++
+			if writes == 3 {
+				return errors.New("mock error")
+			}
+			return write(bf, pos)
+		}
+	}
+	mock := &MockBitManipulatorLE{littleEndian: &littleEndian{}}
+	mock.SetBitFunc = failOnThird(mock.littleEndian.SetBit)
+	mock.ClearBitFunc = failOnThird(mock.littleEndian.ClearBit)
+
+	bf := &BitField{
+		data:        []byte{0b11001100, 0b00110011},
+		size:        16,
+		manipulator: mock,
+	}
+	expectedBits := bf.Bytes()
+
+	if err := bf.InsertUint64(4, 8, 0b01010101); err == nil {
+		t.Fatalf("InsertUint() returned no error, want mock error")
+	}
+	if writes != 3 {
+		t.Errorf("InsertUint() wrote %d bits, want 3", writes)
+	}
+	if !reflect.DeepEqual(bf.data, expectedBits) {
+		t.Errorf("InsertUint() got %v, want unchanged %v", bf.data, expectedBits)
+	}
+}
+
+func TestInsertUint64AlignedLE(t *testing.T) {
+	// The mock has no overrides, but wrapping the manipulator forces the bit-by-bit path.
+	for _, tc := range alignedUint64TestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fast := LittleEndian.FromBytes(alignedUint64Field, 128)
+			slow := LittleEndian.FromBytes(alignedUint64Field, 128)
+			slow.manipulator = &MockBitManipulatorLE{}
+
+			fastErr := fast.InsertUint64(tc.offset, tc.size, tc.value)
+			slowErr := slow.InsertUint64(tc.offset, tc.size, tc.value)
+
+			if fastErr != nil || slowErr != nil {
+				t.Fatalf("InsertUint() returned unexpected errors: %v, %v", fastErr, slowErr)
+			}
+			if !reflect.DeepEqual(fast.data, slow.data) {
+				t.Errorf("InsertUint() got %v, want %v", fast.data, slow.data)
+			}
+		})
+	}
+}
+
 func TestExtractUint64LE(t *testing.T) {
 	for _, tc := range extractUint64TestCasesLE {
 		t.Run(tc.name, func(t *testing.T) {
@@ -534,3 +644,52 @@ func TestExtractUint64LE(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractUint64AlignedLE(t *testing.T) {
+	// The mock has no overrides, but wrapping the manipulator forces the bit-by-bit path.
+	for _, tc := range alignedUint64TestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fast := LittleEndian.FromBytes(alignedUint64Field, 128)
+			slow := LittleEndian.FromBytes(alignedUint64Field, 128)
+			slow.manipulator = &MockBitManipulatorLE{}
+
+			fastValue, fastErr := fast.ExtractUint64(tc.offset, tc.size)
+			slowValue, slowErr := slow.ExtractUint64(tc.offset, tc.size)
+
+			if fastErr != nil || slowErr != nil {
+				t.Fatalf("ExtractUint() returned unexpected errors: %v, %v", fastErr, slowErr)
+			}
+			if fastValue != slowValue {
+				t.Errorf("ExtractUint() got %#x, want %#x", fastValue, slowValue)
+			}
+		})
+	}
+}
+
+func BenchmarkInsertUint64AlignedLE(b *testing.B) {
+	bf := LittleEndian.New(128)
+	for i := 0; i < b.N; i++ {
+		bf.InsertUint64(32, 64, 0x0123456789ABCDEF)
+	}
+}
+
+func BenchmarkInsertUint64RaggedLE(b *testing.B) {
+	bf := LittleEndian.New(128)
+	for i := 0; i < b.N; i++ {
+		bf.InsertUint64(33, 63, 0x0123456789ABCDEF)
+	}
+}
+
+func BenchmarkExtractUint64AlignedLE(b *testing.B) {
+	bf := LittleEndian.FromBytes(alignedUint64Field, 128)
+	for i := 0; i < b.N; i++ {
+		bf.ExtractUint64(32, 64)
+	}
+}
+
+func BenchmarkExtractUint64RaggedLE(b *testing.B) {
+	bf := LittleEndian.FromBytes(alignedUint64Field, 128)
+	for i := 0; i < b.N; i++ {
+		bf.ExtractUint64(33, 63)
+	}
+}
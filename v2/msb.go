@@ -1,7 +1,7 @@
 package bitfield
 
 import (
-	"errors"
+	"fmt"
 )
 
 type bigEndian struct{}
@@ -14,32 +14,41 @@ var BigEndian BitManipulator = &bigEndian{}
 // It calculates the number of bytes needed to store 'n' bits and initializes the BitField
 // with an empty byte slice of that size.
 func (bm *bigEndian) New(n uint64) *BitField {
-	byteSize := (n + 7) / 8
+	byteSize := byteCount(n)
 
 	return &BitField{
-		data:        make([]byte, byteSize),
+		data:        allocData(byteSize, byteSize),
 		size:        n,
 		manipulator: BigEndian,
+		msb0:        true,
 	}
 }
 
 // FromBytes creates a new BitField from a byte slice.
 // It takes the byte slice and the size of the BitField in bits as parameters
 // and returns a pointer to the created BitField.
+// The BitField always holds exactly the bytes needed for size bits: bytes beyond the size are
+// dropped and missing bytes are zero-filled.
 func (bm *bigEndian) FromBytes(bytes []byte, size uint64) *BitField {
-	data := make([]byte, len(bytes))
+	data := allocData(byteCount(size), byteCount(size))
 	copy(data, bytes)
 
 	return &BitField{
 		data:        data,
 		size:        size,
 		manipulator: BigEndian,
+		msb0:        true,
 	}
 }
 
+// msb0 reports whether bit position 0 of a byte is its most significant bit.
+func (bm *bigEndian) msb0() bool {
+	return true
+}
+
 func calcBitPosBE(bf *BitField, pos uint64) (bytePos, bitPos uint64, err error) {
 	if pos >= bf.size {
-		return 0, 0, errors.New("bit position out of range")
+		return 0, 0, fmt.Errorf("%w: position %d, size %d", ErrOutOfRange, pos, bf.size)
 	}
 	bytePos = pos / 8
 	bitPos = 7 - (pos % 8)
@@ -83,18 +92,29 @@ func (bm *bigEndian) TestBit(bf *BitField, pos uint64) (bool, error) {
 }
 
 func (bm *bigEndian) InsertUint64(bf *BitField, offset, size uint64, value uint64) error {
-	if offset+size > bf.size || size > 64 {
-		return errors.New("operation out of bounds or size is invalid")
+	if err := checkUint64Range(bf, offset, size); err != nil {
+		return err
+	}
+
+	if bf.byteAligned(bm, offset, size) {
+		// Whole bytes are written directly, starting with the most significant byte of value.
+		for i := uint64(0); i < size/8; i++ {
+			bf.data[offset/8+i] = byte(value >> (size - 8*(i+1)))
+		}
+		return nil
 	}
 
+	restore := bf.saveRange(offset, size)
 	for i := size; i > 0; i-- {
 		pos := offset + i - 1
 		if (value>>(size-i))&1 == 1 {
 			if err := bf.manipulator.SetBit(bf, pos); err != nil {
+				restore()
 				return err
 			}
 		} else {
 			if err := bf.manipulator.ClearBit(bf, pos); err != nil {
+				restore()
 				return err
 			}
 		}
@@ -104,11 +124,19 @@ func (bm *bigEndian) InsertUint64(bf *BitField, offset, size uint64, value uint6
 }
 
 func (bm *bigEndian) ExtractUint64(bf *BitField, offset, size uint64) (uint64, error) {
-	if offset+size > bf.size || size > 64 {
-		return 0, errors.New("operation out of bounds or size is invalid")
+	if err := checkUint64Range(bf, offset, size); err != nil {
+		return 0, err
 	}
 
 	var group uint64
+	if bf.byteAligned(bm, offset, size) {
+		// Whole bytes are read directly, starting with the most significant byte of the result.
+		for i := uint64(0); i < size/8; i++ {
+			group = group<<8 | uint64(bf.data[offset/8+i])
+		}
+		return group, nil
+	}
+
 	for i := size; i > 0; i-- {
 		bit, err := bf.manipulator.TestBit(bf, offset+i-1)
 		if err != nil {
@@ -89,6 +89,7 @@ var setBitTestCasesBE = []SetBitTestCase{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:          0,
 		expectedBits: []byte{0b10000000, 0b00000000},
@@ -99,6 +100,7 @@ var setBitTestCasesBE = []SetBitTestCase{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:          9,
 		expectedBits: []byte{0b00000000, 0b01000000},
@@ -109,6 +111,7 @@ var setBitTestCasesBE = []SetBitTestCase{
 			data:        []byte{0b10101010},
 			size:        8,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:         100,
 		expectError: true,
@@ -122,6 +125,7 @@ var clearBitTestCasesBE = []ClearBitTestCase{
 			data:        []byte{0b11111111, 0b11111111},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:          0,
 		expectedBits: []byte{0b01111111, 0b11111111},
@@ -132,6 +136,7 @@ var clearBitTestCasesBE = []ClearBitTestCase{
 			data:        []byte{0b11111111, 0b11111111},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:          9,
 		expectedBits: []byte{0b11111111, 0b10111111},
@@ -142,6 +147,7 @@ var clearBitTestCasesBE = []ClearBitTestCase{
 			data:        []byte{0b10101010},
 			size:        8,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:         100,
 		expectError: true,
@@ -155,6 +161,7 @@ var toggleBitTestCasesBE = []ToggleBitTestCase{
 			data:        []byte{0b00000000, 0b11111111},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:          0,
 		expectedBits: []byte{0b10000000, 0b11111111},
@@ -165,6 +172,7 @@ var toggleBitTestCasesBE = []ToggleBitTestCase{
 			data:        []byte{0b00000000, 0b11111111},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:          9,
 		expectedBits: []byte{0b00000000, 0b10111111},
@@ -175,6 +183,7 @@ var toggleBitTestCasesBE = []ToggleBitTestCase{
 			data:        []byte{0b10101010},
 			size:        8,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:         100,
 		expectError: true,
@@ -188,6 +197,7 @@ var testBitTestCasesBE = []TestBitTestCase{
 			data:        []byte{0b10000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:           0,
 		expectedValue: true,
@@ -198,6 +208,7 @@ var testBitTestCasesBE = []TestBitTestCase{
 			data:        []byte{0b11111111, 0b10111111},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:           9,
 		expectedValue: false,
@@ -208,6 +219,7 @@ var testBitTestCasesBE = []TestBitTestCase{
 			data:        []byte{0b10101010},
 			size:        8,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		pos:         100,
 		expectError: true,
@@ -221,6 +233,7 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:       0,
 		size:         8,
@@ -233,6 +246,7 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:      8,
 		size:        10, // This goes beyond the size of BitField
@@ -245,18 +259,46 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:       5,
 		size:         0,
 		value:        0b1,
 		expectedBits: []byte{0b00000000, 0b00000000},
 	},
+	{
+		name: "Insert zero size at end",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:       16,
+		size:         0,
+		value:        0b1,
+		expectedBits: []byte{0b00000000, 0b00000000}, // Nothing is written at offset == size
+	},
+	{
+		name: "Insert zero size beyond end",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:      17,
+		size:        0,
+		value:       0b1,
+		expectError: true, // Only offsets up to the size are valid, even when nothing is written
+	},
 	{
 		name: "Insert at offset",
 		bf: &BitField{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:       4,
 		size:         4,
@@ -269,6 +311,7 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 			data:        make([]byte, 16),
 			size:        128,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:      0,
 		size:        65,
@@ -281,6 +324,7 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 			data:        []byte{0b00000000, 0b00000000, 0b00000000, 0b00000000}, // Initial state with 4 bytes
 			size:        32,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:       4,
 		size:         16,                 // 16-bit value
@@ -292,6 +336,7 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 		bf: &BitField{
 			data: make([]byte, 1),
 			size: 8,
+			msb0: true,
 			manipulator: &MockBitManipulatorBE{
 				SetBitFunc: func(bf *BitField, pos uint64) error {
 					return errors.New("mock error")
@@ -308,6 +353,7 @@ var insertUint64TestCasesBE = []InsertUint64TestCase{
 		bf: &BitField{
 			data: make([]byte, 1),
 			size: 8,
+			msb0: true,
 			manipulator: &MockBitManipulatorBE{
 				ClearBitFunc: func(bf *BitField, pos uint64) error {
 					return errors.New("mock error")
@@ -328,6 +374,7 @@ var extractUint64TestCasesBE = []ExtractUint64TestCase{
 			data:        []byte{0b10101010, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:        0,
 		size:          8,
@@ -339,6 +386,7 @@ var extractUint64TestCasesBE = []ExtractUint64TestCase{
 			data:        []byte{0b00000000, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:      8,
 		size:        10, // This goes beyond the size of BitField
@@ -350,6 +398,7 @@ var extractUint64TestCasesBE = []ExtractUint64TestCase{
 			data:        []byte{0b10101010, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:        5,
 		size:          0,
@@ -361,6 +410,7 @@ var extractUint64TestCasesBE = []ExtractUint64TestCase{
 			data:        []byte{0b00001111, 0b00000000},
 			size:        16,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:        4,
 		size:          4,
@@ -372,6 +422,7 @@ var extractUint64TestCasesBE = []ExtractUint64TestCase{
 			data:        []byte{0b10100000, 0b10101010, 0b00001010, 0b00000000},
 			size:        32,
 			manipulator: BigEndian,
+			msb0:        true,
 		},
 		offset:        4,
 		size:          16,
@@ -382,6 +433,7 @@ var extractUint64TestCasesBE = []ExtractUint64TestCase{
 		bf: &BitField{
 			data: make([]byte, 1),
 			size: 8,
+			msb0: true,
 			manipulator: &MockBitManipulatorBE{
 				TestBitFunc: func(bf *BitField, pos uint64) (bool, error) {
 					return false, errors.New("mock error")
@@ -413,6 +465,24 @@ func TestNewBE(t *testing.T) {
 	}
 }
 
+func TestNewWithCapacityBE(t *testing.T) {
+	for _, tc := range newWithCapacityTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bf := NewWithCapacity(tc.n, tc.capacityBits, BigEndian)
+			if len(bf.data) != tc.expectedLen || cap(bf.data) != tc.expectedCapacity {
+				t.Errorf("%s: expected byte length %d and capacity %d, got %d and %d",
+					tc.name, tc.expectedLen, tc.expectedCapacity, len(bf.data), cap(bf.data))
+			}
+			if bf.size != tc.n {
+				t.Errorf("%s: expected size %d, got %d", tc.name, tc.n, bf.size)
+			}
+			if !bf.StrictEqual(BigEndian.New(tc.n)) {
+				t.Errorf("%s: expected a cleared BitField, got %v", tc.name, bf)
+			}
+		})
+	}
+}
+
 func TestFromBytesBE(t *testing.T) {
 	for _, tc := range fromBytesTestCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -420,8 +490,8 @@ func TestFromBytesBE(t *testing.T) {
 			if bf.size != tc.size {
 				t.Errorf("%s: expected size %d, got %d", tc.name, tc.size, bf.size)
 			}
-			if !reflect.DeepEqual(bf.data, tc.bytes) {
-				t.Errorf("FromBytes() got %v, want %v", bf.data, tc.bytes)
+			if !reflect.DeepEqual(bf.data, tc.expectedBits) {
+				t.Errorf("FromBytes() got %v, want %v", bf.data, tc.expectedBits)
 			}
 			if !reflect.DeepEqual(bf.manipulator, BigEndian) {
 				t.Errorf("%s: expected manipulator %v, got %v", tc.name, BigEndian, bf.manipulator)
@@ -430,6 +500,19 @@ func TestFromBytesBE(t *testing.T) {
 	}
 }
 
+func TestPhysicalPositionBE(t *testing.T) {
+	bf := BigEndian.New(24)
+	for pos := uint64(0); pos < bf.Size(); pos++ {
+		expectedByte, expectedBit, err := calcBitPosBE(bf, pos)
+		if err != nil {
+			t.Fatalf("calcBitPosBE() returned unexpected error: %v", err)
+		}
+		if bytePos, bitInByte := PhysicalPosition(pos, BigEndian); bytePos != expectedByte || bitInByte != expectedBit {
+			t.Errorf("PhysicalPosition(%d) got %d, %d, want %d, %d", pos, bytePos, bitInByte, expectedByte, expectedBit)
+		}
+	}
+}
+
 func TestSetBitBE(t *testing.T) {
 	for _, tc := range setBitTestCasesBE {
 		t.Run(tc.name, func(t *testing.T) {
@@ -518,6 +601,62 @@ func TestInsertUint64BE(t *testing.T) {
 	}
 }
 
+func TestInsertUint64RollbackBE(t *testing.T) {
+	// The mock fails on the third bit written, after two bits have already been changed.
+	writes := 0
+	failOnThird := func(write func(bf *BitField, pos uint64) error) func(bf *BitField, pos uint64) error {
+		return func(bf *BitField, pos uint64) error {
+			writes++
+			if writes == 3 {
+				return errors.New("mock error")
+			}
+			return write(bf, pos)
+		}
+	}
+	mock := &MockBitManipulatorBE{bigEndian: &bigEndian{}}
+	mock.SetBitFunc = failOnThird(mock.bigEndian.SetBit)
+	mock.ClearBitFunc = failOnThird(mock.bigEndian.ClearBit)
+
+	bf := &BitField{
+		data:        []byte{0b11001100, 0b00110011},
+		size:        16,
+		manipulator: mock,
+		msb0:        true,
+	}
+	expectedBits := bf.Bytes()
+
+	if err := bf.InsertUint64(4, 8, 0b01010101); err == nil {
+		t.Fatalf("InsertUint() returned no error, want mock error")
+	}
+	if writes != 3 {
+		t.Errorf("InsertUint() wrote %d bits, want 3", writes)
+	}
+	if !reflect.DeepEqual(bf.data, expectedBits) {
+		t.Errorf("InsertUint() got %v, want unchanged %v", bf.data, expectedBits)
+	}
+}
+
+func TestInsertUint64AlignedBE(t *testing.T) {
+	// The mock has no overrides, but wrapping the manipulator forces the bit-by-bit path.
+	for _, tc := range alignedUint64TestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fast := BigEndian.FromBytes(alignedUint64Field, 128)
+			slow := BigEndian.FromBytes(alignedUint64Field, 128)
+			slow.manipulator = &MockBitManipulatorBE{}
+
+			fastErr := fast.InsertUint64(tc.offset, tc.size, tc.value)
+			slowErr := slow.InsertUint64(tc.offset, tc.size, tc.value)
+
+			if fastErr != nil || slowErr != nil {
+				t.Fatalf("InsertUint() returned unexpected errors: %v, %v", fastErr, slowErr)
+			}
+			if !reflect.DeepEqual(fast.data, slow.data) {
+				t.Errorf("InsertUint() got %v, want %v", fast.data, slow.data)
+			}
+		})
+	}
+}
+
 func TestExtractUint64BE(t *testing.T) {
 	for _, tc := range extractUint64TestCasesBE {
 		t.Run(tc.name, func(t *testing.T) {
@@ -534,3 +673,52 @@ func TestExtractUint64BE(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractUint64AlignedBE(t *testing.T) {
+	// The mock has no overrides, but wrapping the manipulator forces the bit-by-bit path.
+	for _, tc := range alignedUint64TestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fast := BigEndian.FromBytes(alignedUint64Field, 128)
+			slow := BigEndian.FromBytes(alignedUint64Field, 128)
+			slow.manipulator = &MockBitManipulatorBE{}
+
+			fastValue, fastErr := fast.ExtractUint64(tc.offset, tc.size)
+			slowValue, slowErr := slow.ExtractUint64(tc.offset, tc.size)
+
+			if fastErr != nil || slowErr != nil {
+				t.Fatalf("ExtractUint() returned unexpected errors: %v, %v", fastErr, slowErr)
+			}
+			if fastValue != slowValue {
+				t.Errorf("ExtractUint() got %#x, want %#x", fastValue, slowValue)
+			}
+		})
+	}
+}
+
+func BenchmarkInsertUint64AlignedBE(b *testing.B) {
+	bf := BigEndian.New(128)
+	for i := 0; i < b.N; i++ {
+		bf.InsertUint64(32, 64, 0x0123456789ABCDEF)
+	}
+}
+
+func BenchmarkInsertUint64RaggedBE(b *testing.B) {
+	bf := BigEndian.New(128)
+	for i := 0; i < b.N; i++ {
+		bf.InsertUint64(33, 63, 0x0123456789ABCDEF)
+	}
+}
+
+func BenchmarkExtractUint64AlignedBE(b *testing.B) {
+	bf := BigEndian.FromBytes(alignedUint64Field, 128)
+	for i := 0; i < b.N; i++ {
+		bf.ExtractUint64(32, 64)
+	}
+}
+
+func BenchmarkExtractUint64RaggedBE(b *testing.B) {
+	bf := BigEndian.FromBytes(alignedUint64Field, 128)
+	for i := 0; i < b.N; i++ {
+		bf.ExtractUint64(33, 63)
+	}
+}
@@ -0,0 +1,20 @@
+package bitfield
+
+// Canonicalize clears the padding bits in the final byte of the underlying data, which lie at positions
+// greater than or equal to the size of the BitField in the manipulator's numbering.
+// The bits within the size of the BitField are preserved.
+func (bf *BitField) Canonicalize() {
+	bf.clearPadding()
+}
+
+// HasDirtyPadding reports whether any of the padding bits in the final byte of the underlying data is set.
+func (bf *BitField) HasDirtyPadding() bool {
+	n := (bf.size + 7) / 8
+	return n > 0 && bf.data[n-1]&bf.paddingMask() != 0
+}
+
+// CanonicalBytes returns a copy of the underlying data with the padding bits cleared, leaving the BitField unchanged.
+// BitFields that hold the same bits under the same manipulator always return equal slices.
+func (bf *BitField) CanonicalBytes() []byte {
+	return bf.canonical()
+}
@@ -0,0 +1,235 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type CanonicalizeTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	expectedBits []byte    // Expected byte slice after clearing the padding bits
+}
+
+type DirtyPaddingTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // BitField to inspect
+	expectedDirty bool      // Whether any padding bit is expected to be set
+}
+
+type CanonicalBytesTestCase struct {
+	name  string    // Name of the test case
+	clean *BitField // BitField with cleared padding bits
+	dirty *BitField // BitField with the same logical bits and set padding bits
+}
+
+// Test cases
+
+var canonicalizeTestCases = []CanonicalizeTestCase{
+	{
+		name:         "Empty BitField",
+		bf:           LittleEndian.New(0),
+		expectedBits: []byte{},
+	},
+	{
+		name: "Byte-aligned field has no padding",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b11111111, 0b11111111},
+	},
+	{
+		name: "High padding bits cleared LE",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b11110101},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b10101010, 0b00000101},
+	},
+	{
+		name: "Low padding bits cleared BE",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b01011111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b10101010, 0b01010000},
+	},
+	{
+		name: "Single bit field LE",
+		bf: &BitField{
+			data:        []byte{0b11111111},
+			size:        1,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00000001},
+	},
+	{
+		name: "Single bit field BE",
+		bf: &BitField{
+			data:        []byte{0b11111111},
+			size:        1,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b10000000},
+	},
+}
+
+var hasDirtyPaddingTestCases = []DirtyPaddingTestCase{
+	{
+		name: "Empty BitField",
+		bf:   LittleEndian.New(0),
+	},
+	{
+		name: "Byte-aligned field",
+		bf: &BitField{
+			data:        []byte{0b11111111},
+			size:        8,
+			manipulator: LittleEndian,
+		},
+	},
+	{
+		name: "Clean padding LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00001111},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+	},
+	{
+		name: "Dirty padding LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00010000},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedDirty: true,
+	},
+	{
+		name: "Clean padding BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11110000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+	},
+	{
+		name: "Dirty padding BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00001000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedDirty: true,
+	},
+}
+
+var canonicalBytesTestCases = []CanonicalBytesTestCase{
+	{
+		name: "Dirty high padding LE",
+		clean: &BitField{
+			data:        []byte{0b10110100, 0b00000110},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		dirty: &BitField{
+			data:        []byte{0b10110100, 0b11110110},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+	},
+	{
+		name: "Dirty low padding BE",
+		clean: &BitField{
+			data:        []byte{0b10110100, 0b01100000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		dirty: &BitField{
+			data:        []byte{0b10110100, 0b01101111},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+	},
+	{
+		name: "Single bit field LE",
+		clean: &BitField{
+			data:        []byte{0b00000001},
+			size:        1,
+			manipulator: LittleEndian,
+		},
+		dirty: &BitField{
+			data:        []byte{0b11111111},
+			size:        1,
+			manipulator: LittleEndian,
+		},
+	},
+}
+
+// Test functions
+
+func TestCanonicalize(t *testing.T) {
+	for _, tc := range canonicalizeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.bf.Canonicalize()
+
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("Canonicalize() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestHasDirtyPadding(t *testing.T) {
+	for _, tc := range hasDirtyPaddingTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.bf.HasDirtyPadding(); got != tc.expectedDirty {
+				t.Errorf("HasDirtyPadding() got %v, want %v", got, tc.expectedDirty)
+			}
+
+			tc.bf.Canonicalize()
+			if tc.bf.HasDirtyPadding() {
+				t.Errorf("HasDirtyPadding() got true after Canonicalize(), want false")
+			}
+		})
+	}
+}
+
+func TestCanonicalBytes(t *testing.T) {
+	for _, tc := range canonicalBytesTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := append([]byte(nil), tc.dirty.data...)
+
+			got, want := tc.dirty.CanonicalBytes(), tc.clean.CanonicalBytes()
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("CanonicalBytes() got %v, want %v", got, want)
+			}
+			if !reflect.DeepEqual(want, tc.clean.data) {
+				t.Errorf("CanonicalBytes() of clean field got %v, want %v", want, tc.clean.data)
+			}
+
+			// The receiver keeps its padding bits
+			if !reflect.DeepEqual(tc.dirty.data, before) {
+				t.Errorf("CanonicalBytes() modified the BitField: got %v, want %v", tc.dirty.data, before)
+			}
+
+			// The result does not alias the underlying data
+			got[0] ^= 0xFF
+			if tc.dirty.data[0] != before[0] {
+				t.Errorf("CanonicalBytes() returned a slice sharing the underlying data")
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package bitfield
+
+import (
+	"fmt"
+)
+
+// checkRange returns an error if the positions [offset, offset+count) are not within the size of the BitField.
+func (bf *BitField) checkRange(offset, count uint64) error {
+	// The comparison is arranged so that offset+count cannot overflow.
+	if offset > bf.size || count > bf.size-offset {
+		return fmt.Errorf("%w: %d bits at offset %d, size %d", ErrOutOfRange, count, offset, bf.size)
+	}
+	return nil
+}
+
+// checkUint64Range returns an error if size bits starting at offset do not fit in a uint64
+// or are not within the size of the BitField.
+func checkUint64Range(bf *BitField, offset, size uint64) error {
+	if size > 64 {
+		return fmt.Errorf("%w: got %d", ErrSizeTooLarge, size)
+	}
+	return bf.checkRange(offset, size)
+}
+
+// forEachRangeByte calls fn with the index and bit mask of every byte covering the positions [offset, offset+count).
+// Bytes that are fully covered by the range are passed a mask of 0xFF.
+func (bf *BitField) forEachRangeByte(offset, count uint64, fn func(i uint64, mask byte)) {
+	bf.allRangeBytes(offset, count, func(i uint64, mask byte) bool {
+		fn(i, mask)
+		return true
+	})
+}
+
+// allRangeBytes reports whether fn returns true for the index and bit mask of every byte covering the positions
+// [offset, offset+count), in the same way as forEachRangeByte. It stops at the first byte for which fn returns false.
+func (bf *BitField) allRangeBytes(offset, count uint64, fn func(i uint64, mask byte) bool) bool {
+	end := offset + count
+	for pos := offset; pos < end; {
+		i := pos / 8
+		to := min(end-i*8, 8)
+		if !fn(i, bf.posMask(pos%8, to)) {
+			return false
+		}
+		pos = i*8 + to
+	}
+	return true
+}
+
+// applyRange replaces every byte covering the positions [offset, offset+count) with the result of op,
+// which is passed the current byte and the mask of its bits that lie within the range.
+func (bf *BitField) applyRange(offset, count uint64, op func(b, mask byte) byte) error {
+	if err := bf.checkRange(offset, count); err != nil {
+		return err
+	}
+
+	bf.forEachRangeByte(offset, count, func(i uint64, mask byte) {
+		bf.data[i] = op(bf.data[i], mask)
+	})
+	return nil
+}
+
+// SetRange sets count bits starting at offset to 1.
+// Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) SetRange(offset, count uint64) error {
+	if bf.err == nil {
+		bf.err = bf.applyRange(offset, count, func(b, mask byte) byte { return b | mask })
+	}
+	return bf.err
+}
+
+// ClearRange sets count bits starting at offset to 0.
+// Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) ClearRange(offset, count uint64) error {
+	if bf.err == nil {
+		bf.err = bf.applyRange(offset, count, func(b, mask byte) byte { return b &^ mask })
+	}
+	return bf.err
+}
+
+// ToggleRange toggles count bits starting at offset.
+// Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) ToggleRange(offset, count uint64) error {
+	if bf.err == nil {
+		bf.err = bf.applyRange(offset, count, func(b, mask byte) byte { return b ^ mask })
+	}
+	return bf.err
+}
+
+// FillPattern sets every byte of the underlying data to pattern and clears the padding bits,
+// so that e.g. 0xAA yields alternating bits.
+func (bf *BitField) FillPattern(pattern byte) {
+	for i := range bf.data {
+		bf.data[i] = pattern
+	}
+	bf.clearPadding()
+}
@@ -0,0 +1,287 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type RangeTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	offset       uint64    // The starting position of the range
+	count        uint64    // The number of bits in the range
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice after the operation
+}
+
+type FillPatternTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	pattern      byte      // Pattern to fill every byte with
+	expectedBits []byte    // Expected byte slice after filling
+}
+
+// Test cases
+
+var setRangeTestCases = []RangeTestCase{
+	{
+		name:         "Set within a single byte LE",
+		bf:           LittleEndian.New(16),
+		offset:       2,
+		count:        3,
+		expectedBits: []byte{0b00011100, 0b00000000},
+	},
+	{
+		name:         "Set within a single byte BE",
+		bf:           BigEndian.New(16),
+		offset:       2,
+		count:        3,
+		expectedBits: []byte{0b00111000, 0b00000000},
+	},
+	{
+		name:         "Set starting and ending mid-byte LE",
+		bf:           LittleEndian.New(32),
+		offset:       5,
+		count:        20,
+		expectedBits: []byte{0b11100000, 0b11111111, 0b11111111, 0b00000001},
+	},
+	{
+		name:         "Set starting and ending mid-byte BE",
+		bf:           BigEndian.New(32),
+		offset:       5,
+		count:        20,
+		expectedBits: []byte{0b00000111, 0b11111111, 0b11111111, 0b10000000},
+	},
+	{
+		name:         "Set whole bytes",
+		bf:           BigEndian.New(24),
+		offset:       8,
+		count:        16,
+		expectedBits: []byte{0b00000000, 0b11111111, 0b11111111},
+	},
+	{
+		name:         "Set zero bits",
+		bf:           LittleEndian.New(8),
+		offset:       8,
+		count:        0,
+		expectedBits: []byte{0b00000000},
+	},
+	{
+		name:        "Set beyond size",
+		bf:          LittleEndian.New(10),
+		offset:      4,
+		count:       7,
+		expectError: true,
+	},
+}
+
+var clearRangeTestCases = []RangeTestCase{
+	{
+		name: "Clear across a byte boundary LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		offset:       6,
+		count:        4,
+		expectedBits: []byte{0b00111111, 0b11111100},
+	},
+	{
+		name: "Clear across a byte boundary BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:       6,
+		count:        4,
+		expectedBits: []byte{0b11111100, 0b00111111},
+	},
+	{
+		name: "Clear covering part of first and last bytes LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111, 0b11111111},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		offset:       3,
+		count:        18,
+		expectedBits: []byte{0b00000111, 0b00000000, 0b11100000},
+	},
+	{
+		name: "Clear covering part of first and last bytes BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111, 0b11111111},
+			size:        24,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:       3,
+		count:        18,
+		expectedBits: []byte{0b11100000, 0b00000000, 0b00000111},
+	},
+	{
+		name: "Clear beyond size",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:      10,
+		count:       7,
+		expectError: true,
+	},
+}
+
+var toggleRangeTestCases = []RangeTestCase{
+	{
+		name: "Toggle ragged edges LE",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b10101010, 0b10101010},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		offset:       4,
+		count:        14,
+		expectedBits: []byte{0b01011010, 0b01010101, 0b10101001},
+	},
+	{
+		name: "Toggle ragged edges BE",
+		bf: &BitField{
+			data:        []byte{0b10101010, 0b10101010, 0b10101010},
+			size:        24,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:       4,
+		count:        14,
+		expectedBits: []byte{0b10100101, 0b01010101, 0b01101010},
+	},
+	{
+		name:        "Toggle beyond size",
+		bf:          LittleEndian.New(8),
+		offset:      1,
+		count:       8,
+		expectError: true,
+	},
+}
+
+var fillPatternTestCases = []FillPatternTestCase{
+	{
+		name:         "Empty BitField",
+		bf:           LittleEndian.New(0),
+		pattern:      0xFF,
+		expectedBits: []byte{},
+	},
+	{
+		name:         "Alternating pattern",
+		bf:           BigEndian.New(16),
+		pattern:      0xAA,
+		expectedBits: []byte{0b10101010, 0b10101010},
+	},
+	{
+		name:         "Existing bits are overwritten",
+		bf:           LittleEndian.FromBytes([]byte{0b11110000, 0b00001111}, 16),
+		pattern:      0x00,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name:         "Padding cleared LE",
+		bf:           LittleEndian.New(12),
+		pattern:      0xFF,
+		expectedBits: []byte{0b11111111, 0b00001111},
+	},
+	{
+		name:         "Padding cleared BE",
+		bf:           BigEndian.New(12),
+		pattern:      0xFF,
+		expectedBits: []byte{0b11111111, 0b11110000},
+	},
+}
+
+// Test functions
+
+func runRangeTest(t *testing.T, name string, op func(bf *BitField, offset, count uint64) error, tc RangeTestCase) {
+	err := op(tc.bf, tc.offset, tc.count)
+
+	if (err != nil) != tc.expectError {
+		t.Errorf("%s() returned unexpected error: got %v, want %v", name, err, tc.expectError)
+		return
+	}
+
+	if !tc.expectError && !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+		t.Errorf("%s() got %v, want %v", name, tc.bf.data, tc.expectedBits)
+	}
+}
+
+func TestSetRange(t *testing.T) {
+	for _, tc := range setRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRangeTest(t, "SetRange", (*BitField).SetRange, tc)
+		})
+	}
+}
+
+func TestClearRange(t *testing.T) {
+	for _, tc := range clearRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRangeTest(t, "ClearRange", (*BitField).ClearRange, tc)
+		})
+	}
+}
+
+func TestToggleRange(t *testing.T) {
+	for _, tc := range toggleRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRangeTest(t, "ToggleRange", (*BitField).ToggleRange, tc)
+		})
+	}
+}
+
+func TestToggleRangeTwice(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		original := []byte{0b11001010, 0b01110001, 0b10011100}
+		bf := m.FromBytes(original, 24)
+
+		bf.ToggleRange(3, 17)
+		bf.ToggleRange(3, 17)
+
+		if err := bf.Error(); err != nil {
+			t.Errorf("ToggleRange() returned unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(bf.data, original) {
+			t.Errorf("ToggleRange() twice got %v, want %v", bf.data, original)
+		}
+	}
+}
+
+func TestFillPattern(t *testing.T) {
+	for _, tc := range fillPatternTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.bf.FillPattern(tc.pattern)
+
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("FillPattern() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestFillPatternOnesCount(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(12)
+		bf.FillPattern(0xFF)
+
+		if got := bf.OnesCount(); got != 12 {
+			t.Errorf("OnesCount() got %d, want 12", got)
+		}
+		if bf.HasDirtyPadding() {
+			t.Errorf("HasDirtyPadding() got true, want false")
+		}
+	}
+}
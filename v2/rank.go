@@ -0,0 +1,31 @@
+package bitfield
+
+import (
+	"math/bits"
+)
+
+// Rank returns the number of bits set to 1 at the positions [0, pos).
+// Returns an error if pos is greater than the size of the BitField.
+func (bf *BitField) Rank(pos uint64) (uint64, error) {
+	return bf.OnesCountRange(0, pos)
+}
+
+// Select returns the position of the k-th bit set to 1, counting from 0 in ascending order of position.
+// The boolean result is false if fewer than k+1 bits are set, in which case the position is 0.
+func (bf *BitField) Select(k uint64) (uint64, bool) {
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		b := bf.maskedByte(i)
+		if ones := uint64(bits.OnesCount8(b)); k >= ones {
+			k -= ones
+			continue
+		}
+
+		// In normalized form bit j holds in-byte position j, so the lowest k set bits are dropped first.
+		b = bf.normalize(b)
+		for ; k > 0; k-- {
+			b &= b - 1
+		}
+		return i*8 + uint64(bits.TrailingZeros8(b)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,201 @@
+package bitfield
+
+import (
+	"testing"
+)
+
+// Test case structs
+
+type RankTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // BitField to query
+	pos          uint64    // Position to rank
+	expectError  bool      // Whether an error is expected
+	expectedRank uint64    // Expected number of bits set before pos
+}
+
+type SelectTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // BitField to query
+	k             uint64    // Index of the set bit to find
+	expectedFound bool      // Whether the k-th set bit is expected to exist
+	expectedPos   uint64    // Expected position of the k-th set bit
+}
+
+// Test cases
+
+var rankFieldLE = &BitField{
+	data:        []byte{0b10010011, 0b00000101},
+	size:        12,
+	manipulator: LittleEndian,
+}
+
+var rankFieldBE = &BitField{
+	data:        []byte{0b10010011, 0b10100000},
+	size:        12,
+	manipulator: BigEndian,
+	msb0:        true,
+}
+
+var rankTestCases = []RankTestCase{
+	{
+		name:         "Rank of position 0 LE",
+		bf:           rankFieldLE,
+		pos:          0,
+		expectedRank: 0,
+	},
+	{
+		name:         "Rank mid-byte LE",
+		bf:           rankFieldLE,
+		pos:          5,
+		expectedRank: 3, // Positions 0, 1 and 4
+	},
+	{
+		name:         "Rank mid-byte BE",
+		bf:           rankFieldBE,
+		pos:          5,
+		expectedRank: 2, // Positions 0 and 3
+	},
+	{
+		name:         "Rank across bytes BE",
+		bf:           rankFieldBE,
+		pos:          10,
+		expectedRank: 5, // Positions 0, 3, 6, 7 and 8
+	},
+	{
+		name:        "Position beyond size",
+		bf:          rankFieldLE,
+		pos:         13,
+		expectError: true,
+	},
+}
+
+var selectTestCases = []SelectTestCase{
+	{
+		name:          "First set bit LE",
+		bf:            rankFieldLE,
+		k:             0,
+		expectedFound: true,
+		expectedPos:   0,
+	},
+	{
+		name:          "Third set bit LE",
+		bf:            rankFieldLE,
+		k:             2,
+		expectedFound: true,
+		expectedPos:   4,
+	},
+	{
+		name:          "First set bit in second byte BE",
+		bf:            rankFieldBE,
+		k:             4,
+		expectedFound: true,
+		expectedPos:   8,
+	},
+	{
+		name:          "Last set bit BE",
+		bf:            rankFieldBE,
+		k:             5,
+		expectedFound: true,
+		expectedPos:   10,
+	},
+	{
+		name: "Sparse field",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00000000, 0b00000000, 0b01000000},
+			size:        32,
+			manipulator: LittleEndian,
+		},
+		k:             0,
+		expectedFound: true,
+		expectedPos:   30,
+	},
+	{
+		name: "Dense field",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111, 0b11111111},
+			size:        24,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		k:             17,
+		expectedFound: true,
+		expectedPos:   17,
+	},
+	{
+		name:          "k equal to the number of set bits",
+		bf:            rankFieldLE,
+		k:             6,
+		expectedFound: false,
+	},
+	{
+		name: "Padding bits are not selected",
+		bf: &BitField{
+			data:        []byte{0b11110001},
+			size:        4,
+			manipulator: LittleEndian,
+		},
+		k:             1,
+		expectedFound: false,
+	},
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		k:             0,
+		expectedFound: false,
+	},
+}
+
+// Test functions
+
+func TestRank(t *testing.T) {
+	for _, tc := range rankTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rank, err := tc.bf.Rank(tc.pos)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("Rank() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if rank != tc.expectedRank {
+				t.Errorf("Rank() got %d, want %d", rank, tc.expectedRank)
+			}
+		})
+	}
+}
+
+func TestRankOfSize(t *testing.T) {
+	for _, bf := range []*BitField{rankFieldLE, rankFieldBE} {
+		rank, err := bf.Rank(bf.Size())
+		if err != nil {
+			t.Fatalf("Rank() returned unexpected error: %v", err)
+		}
+		if rank != bf.OnesCount() {
+			t.Errorf("Rank() got %d, want OnesCount() %d", rank, bf.OnesCount())
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	for _, tc := range selectTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pos, found := tc.bf.Select(tc.k)
+
+			if found != tc.expectedFound || pos != tc.expectedPos {
+				t.Errorf("Select() got %d, %t, want %d, %t", pos, found, tc.expectedPos, tc.expectedFound)
+			}
+		})
+	}
+}
+
+func TestSelectInvertsRank(t *testing.T) {
+	for _, bf := range []*BitField{rankFieldLE, rankFieldBE} {
+		for k := uint64(0); k < bf.OnesCount(); k++ {
+			pos, _ := bf.Select(k)
+			if rank, _ := bf.Rank(pos); rank != k {
+				t.Errorf("Rank(Select(%d)) got %d, want %d", k, rank, k)
+			}
+		}
+	}
+}
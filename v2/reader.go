@@ -0,0 +1,44 @@
+package bitfield
+
+import (
+	"fmt"
+	"io"
+)
+
+// BitReader reads consecutive bits from a BitField, starting at position 0.
+type BitReader struct {
+	bf  *BitField // The BitField being read from.
+	pos uint64    // The position of the next bit to read.
+}
+
+// NewBitReader returns a BitReader that reads from bf using its manipulator.
+func NewBitReader(bf *BitField) *BitReader {
+	return &BitReader{bf: bf}
+}
+
+// ReadBits reads count bits at the current position, in the same bit order as ExtractUint64,
+// and advances past them. Returns io.EOF without advancing if fewer than count bits remain,
+// and an error if count is greater than 64.
+func (r *BitReader) ReadBits(count uint64) (uint64, error) {
+	if count > 64 {
+		return 0, fmt.Errorf("%w: got %d", ErrSizeTooLarge, count)
+	}
+	if count > r.Remaining() {
+		return 0, io.EOF
+	}
+
+	value, err := r.bf.ExtractUint64(r.pos, count)
+	if err != nil {
+		return 0, err
+	}
+	r.pos += count
+	return value, nil
+}
+
+// Remaining returns the number of bits that have not been read yet.
+func (r *BitReader) Remaining() uint64 {
+	if r.pos >= r.bf.size {
+		return 0
+	}
+	return r.bf.size - r.pos
+}
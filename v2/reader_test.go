@@ -0,0 +1,81 @@
+package bitfield
+
+import (
+	"io"
+	"testing"
+)
+
+// Test case structs
+
+type ReadBitsTestCase struct {
+	count         uint64 // The number of bits to read
+	expectedValue uint64 // Expected value read
+	expectError   error  // Expected error, if any
+}
+
+// Test cases
+
+var readBitsTestCasesLE = []ReadBitsTestCase{
+	{count: 4, expectedValue: 0x4},
+	{count: 8, expectedValue: 0x23},
+	{count: 0, expectedValue: 0},
+	{count: 3, expectedValue: 0b001},
+	{count: 2, expectedValue: 0b00},
+	{count: 4, expectError: io.EOF}, // Only 3 bits remain
+	{count: 3, expectedValue: 0b101},
+	{count: 1, expectError: io.EOF},
+}
+
+var readBitsTestCasesBE = []ReadBitsTestCase{
+	{count: 4, expectedValue: 0x1},
+	{count: 8, expectedValue: 0x23},
+	{count: 0, expectedValue: 0},
+	{count: 3, expectedValue: 0b010},
+	{count: 2, expectedValue: 0b01},
+	{count: 4, expectError: io.EOF}, // Only 3 bits remain
+	{count: 3, expectedValue: 0b100},
+	{count: 1, expectError: io.EOF},
+}
+
+// Test functions
+
+func runReadBitsTest(t *testing.T, r *BitReader, testCases []ReadBitsTestCase) {
+	for i, tc := range testCases {
+		remaining := r.Remaining()
+		value, err := r.ReadBits(tc.count)
+
+		if err != tc.expectError {
+			t.Errorf("ReadBits() #%d returned unexpected error: got %v, want %v", i, err, tc.expectError)
+			continue
+		}
+
+		if err != nil {
+			if r.Remaining() != remaining {
+				t.Errorf("ReadBits() #%d advanced on error: got %d remaining, want %d", i, r.Remaining(), remaining)
+			}
+			continue
+		}
+
+		if value != tc.expectedValue {
+			t.Errorf("ReadBits() #%d got %#x, want %#x", i, value, tc.expectedValue)
+		}
+		if r.Remaining() != remaining-tc.count {
+			t.Errorf("Remaining() #%d got %d, want %d", i, r.Remaining(), remaining-tc.count)
+		}
+	}
+}
+
+func TestBitReaderLE(t *testing.T) {
+	runReadBitsTest(t, NewBitReader(LittleEndian.FromBytes([]byte{0x34, 0x12, 0x0A}, 20)), readBitsTestCasesLE)
+}
+
+func TestBitReaderBE(t *testing.T) {
+	runReadBitsTest(t, NewBitReader(BigEndian.FromBytes([]byte{0x12, 0x34, 0xC0}, 20)), readBitsTestCasesBE)
+}
+
+func TestBitReaderInvalidCount(t *testing.T) {
+	r := NewBitReader(LittleEndian.New(128))
+	if _, err := r.ReadBits(65); err == nil || err == io.EOF {
+		t.Errorf("ReadBits() with count 65 got error %v, want a size error", err)
+	}
+}
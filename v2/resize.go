@@ -0,0 +1,61 @@
+package bitfield
+
+// Resize changes the size of the BitField to newSize bits, reallocating the underlying data as needed.
+// Growing preserves the existing bits and clears the new high positions. Shrinking discards the
+// high positions and clears the bits that become padding.
+func (bf *BitField) Resize(newSize uint64) error {
+	if bf.err == nil {
+		bf.resize(newSize)
+	}
+	return bf.err
+}
+
+func (bf *BitField) resize(newSize uint64) {
+	// Clear the current padding bits, which become logical bits when growing.
+	bf.clearPadding()
+
+	oldLen, newLen := byteCount(bf.size), byteCount(newSize)
+	if newLen > uint64(cap(bf.data)) {
+		// The capacity is at least doubled, so that repeated growth takes amortized constant time.
+		data := allocData(newLen, max(newLen, 2*uint64(cap(bf.data))))
+		copy(data, bf.data[:oldLen])
+		bf.data = data
+	} else {
+		bf.data = bf.data[:newLen]
+		if newLen > oldLen {
+			clear(bf.data[oldLen:])
+		}
+	}
+
+	bf.size = newSize
+	bf.clearPadding()
+}
+
+// Append grows the BitField by the size of other and copies the bits of other into the new high positions.
+// The bits are copied position by position, so other may use a different manipulator.
+func (bf *BitField) Append(other *BitField) error {
+	if bf.err == nil {
+		offset, count := bf.size, other.size
+		bf.resize(offset + count)
+		copyBits(bf, offset, other, 0, count)
+	}
+	return bf.err
+}
+
+// Join creates a new BitField that uses the manipulator m and holds the bits of fields one after another,
+// so that its size is the sum of their sizes. The bits are copied position by position, so the fields
+// may use manipulators other than m.
+func Join(m BitManipulator, fields ...*BitField) *BitField {
+	var size uint64
+	for _, f := range fields {
+		size += f.size
+	}
+
+	bf := m.New(size)
+	var offset uint64
+	for _, f := range fields {
+		copyBits(bf, offset, f, 0, f.size)
+		offset += f.size
+	}
+	return bf
+}
@@ -0,0 +1,255 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type ResizeTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	newSize      uint64    // The size to resize to
+	expectedBits []byte    // Expected byte slice after resizing
+}
+
+type AppendTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	other        *BitField // The BitField to append
+	expectedSize uint64    // Expected size after appending
+	expectedBits []byte    // Expected byte slice after appending
+}
+
+// Test cases
+
+var resizeTestCases = []ResizeTestCase{
+	{
+		name: "Grow within the final byte LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111101},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		newSize:      14,
+		expectedBits: []byte{0b11111111, 0b00000001}, // Former padding bits read as zero
+	},
+	{
+		name: "Grow within the final byte BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b10111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		newSize:      14,
+		expectedBits: []byte{0b11111111, 0b10000000}, // Former padding bits read as zero
+	},
+	{
+		name: "Grow by several bytes",
+		bf: &BitField{
+			data:        []byte{0b00000101},
+			size:        3,
+			manipulator: LittleEndian,
+		},
+		newSize:      20,
+		expectedBits: []byte{0b00000101, 0b00000000, 0b00000000},
+	},
+	{
+		name: "Shrink LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111, 0b11111111},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		newSize:      11,
+		expectedBits: []byte{0b11111111, 0b00000111},
+	},
+	{
+		name: "Shrink BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111, 0b11111111},
+			size:        24,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		newSize:      11,
+		expectedBits: []byte{0b11111111, 0b11100000},
+	},
+	{
+		name: "Shrink to zero",
+		bf: &BitField{
+			data:        []byte{0b11111111},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		newSize:      0,
+		expectedBits: []byte{},
+	},
+}
+
+var appendTestCases = []AppendTestCase{
+	{
+		name: "Append 5 bits onto 3 bits LE",
+		bf: &BitField{
+			data:        []byte{0b00000101},
+			size:        3,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b00010011},
+			size:        5,
+			manipulator: LittleEndian,
+		},
+		expectedSize: 8,
+		expectedBits: []byte{0b10011101},
+	},
+	{
+		name: "Append 5 bits onto 3 bits BE",
+		bf: &BitField{
+			data:        []byte{0b10100000},
+			size:        3,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b11001000},
+			size:        5,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedSize: 8,
+		expectedBits: []byte{0b10111001},
+	},
+	{
+		name: "Append across a byte boundary with dirty padding",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111101},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		other: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        9,
+			manipulator: LittleEndian,
+		},
+		expectedSize: 19,
+		expectedBits: []byte{0b11111111, 0b11111101, 0b00000111},
+	},
+	{
+		name: "Append LE field onto BE field",
+		bf: &BitField{
+			data:        []byte{0b10000000},
+			size:        1,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		other: &BitField{
+			data:        []byte{0b00000001},
+			size:        2,
+			manipulator: LittleEndian,
+		},
+		expectedSize: 3,
+		expectedBits: []byte{0b11000000},
+	},
+	{
+		name:         "Append empty field",
+		bf:           LittleEndian.New(4),
+		other:        LittleEndian.New(0),
+		expectedSize: 4,
+		expectedBits: []byte{0b00000000},
+	},
+}
+
+// Test functions
+
+func TestResize(t *testing.T) {
+	for _, tc := range resizeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.bf.Resize(tc.newSize); err != nil {
+				t.Errorf("Resize() returned unexpected error: %v", err)
+				return
+			}
+
+			if tc.bf.size != tc.newSize {
+				t.Errorf("Resize() got size %d, want %d", tc.bf.size, tc.newSize)
+			}
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("Resize() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestResizeGrowThenShrink(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(13)
+		bf.InsertUint64(0, 13, 0b1011001110101)
+
+		bf.Resize(40)
+		bf.SetRange(13, 27) // Fill the grown positions
+		bf.Resize(13)
+		bf.Resize(40)
+
+		if err := bf.Error(); err != nil {
+			t.Errorf("Resize() returned unexpected error: %v", err)
+		}
+		if value, _ := bf.ExtractUint64(0, 13); value != 0b1011001110101 {
+			t.Errorf("Resize() surviving bits got %b, want %b", value, 0b1011001110101)
+		}
+		if value, _ := bf.ExtractUint64(13, 27); value != 0 {
+			t.Errorf("Resize() regrown bits got %b, want 0", value)
+		}
+	}
+}
+
+func TestAppend(t *testing.T) {
+	for _, tc := range appendTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.bf.Append(tc.other); err != nil {
+				t.Errorf("Append() returned unexpected error: %v", err)
+				return
+			}
+
+			if tc.bf.size != tc.expectedSize {
+				t.Errorf("Append() got size %d, want %d", tc.bf.size, tc.expectedSize)
+			}
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("Append() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	fields := []*BitField{
+		LittleEndian.FromBytes([]byte{0b00000101}, 3),
+		BigEndian.FromBytes([]byte{0b01101000}, 5),
+		LittleEndian.FromBytes([]byte{0b11111110, 0b00000010}, 10),
+	}
+	expected := []bool{
+		true, false, true, // First field
+		false, true, true, false, true, // Second field
+		false, true, true, true, true, true, true, true, false, true, // Third field
+	}
+
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := Join(m, fields...)
+
+		if bf.size != 18 || bf.manipulator != m {
+			t.Errorf("Join() got size %d and manipulator %v, want %d and %v", bf.size, bf.manipulator, 18, m)
+		}
+		if bits, err := bf.ExtractBools(0, bf.size); err != nil || !reflect.DeepEqual(bits, expected) {
+			t.Errorf("Join() got bits %v (%v), want %v", bits, err, expected)
+		}
+		if bf.HasDirtyPadding() {
+			t.Errorf("Join() left padding bits set: %v", bf.data)
+		}
+	}
+
+	if bf := Join(BigEndian); bf.size != 0 || len(bf.data) != 0 {
+		t.Errorf("Join() of no fields got %+v, want an empty BitField", bf)
+	}
+}
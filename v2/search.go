@@ -0,0 +1,118 @@
+package bitfield
+
+import (
+	"math/bits"
+)
+
+// firstPosInByte returns the lowest in-byte position of a set bit in b, which must be non-zero.
+func (bf *BitField) firstPosInByte(b byte) uint64 {
+	if bf.msb0 {
+		return uint64(bits.LeadingZeros8(b))
+	}
+	return uint64(bits.TrailingZeros8(b))
+}
+
+// FindFirstSet returns the lowest position of a bit set to 1 in the BitField.
+// The boolean result is false if no bit is set, in which case the position is 0.
+func (bf *BitField) FindFirstSet() (uint64, bool) {
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if b := bf.maskedByte(i); b != 0 {
+			return i*8 + bf.firstPosInByte(b), true
+		}
+	}
+	return 0, false
+}
+
+// FindFirstClear returns the lowest position of a bit set to 0 within the size of the BitField.
+// The boolean result is false if every bit is set, in which case the position is 0.
+func (bf *BitField) FindFirstClear() (uint64, bool) {
+	for i := uint64(0); i < (bf.size+7)/8; i++ {
+		if b := ^bf.data[i] &^ bf.paddingMaskAt(i); b != 0 {
+			return i*8 + bf.firstPosInByte(b), true
+		}
+	}
+	return 0, false
+}
+
+// FindNextSet returns the lowest position greater than or equal to from of a bit set to 1 in the BitField.
+// The boolean result is false if no such bit is set, including when from is beyond the size of the BitField.
+func (bf *BitField) FindNextSet(from uint64) (uint64, bool) {
+	if from >= bf.size {
+		return 0, false
+	}
+
+	i := from / 8
+	if b := bf.maskedByte(i) & bf.posMask(from%8, 8); b != 0 {
+		return i*8 + bf.firstPosInByte(b), true
+	}
+	for i++; i < (bf.size+7)/8; i++ {
+		if b := bf.maskedByte(i); b != 0 {
+			return i*8 + bf.firstPosInByte(b), true
+		}
+	}
+	return 0, false
+}
+
+// findRun returns the lowest position at which count consecutive bits are all equal to value.
+// Whole bytes that match or do not match at all are handled at once, so long runs and fully
+// occupied regions are skipped a byte at a time.
+func (bf *BitField) findRun(count uint64, value bool) (uint64, bool) {
+	if count > bf.size {
+		return 0, false
+	}
+	if count == 0 {
+		return 0, true
+	}
+
+	var start, run uint64
+	n := (bf.size + 7) / 8
+	for i := uint64(0); i < n; i++ {
+		// b holds a 1 at every in-byte position, counting from position 0, whose bit equals value.
+		b := bf.normalize(bf.maskedByte(i))
+		if !value {
+			b = ^b
+		}
+		if i == n-1 && bf.size%8 != 0 {
+			b &= 1<<(bf.size%8) - 1
+		}
+
+		switch b {
+		case 0xFF:
+			if run == 0 {
+				start = i * 8
+			}
+			run += 8
+		case 0:
+			run = 0
+		default:
+			for j := uint64(0); j < 8 && run < count; j++ {
+				if b&(1<<j) == 0 {
+					run = 0
+					continue
+				}
+				if run == 0 {
+					start = i*8 + j
+				}
+				run++
+			}
+		}
+		if run >= count {
+			return start, true
+		}
+	}
+	return 0, false
+}
+
+// FindClearRun returns the lowest position at which count consecutive bits are set to 0, which is
+// the first fit for count bits in a bitmap allocator. The boolean result is false if there is no such run,
+// in which case the position is 0. A count of 0 is found at position 0.
+func (bf *BitField) FindClearRun(count uint64) (uint64, bool) {
+	return bf.findRun(count, false)
+}
+
+// FindSetRun returns the lowest position at which count consecutive bits are set to 1, such as the start
+// of an allocated region of count bits. The boolean result is false if there is no such run,
+// in which case the position is 0. A count of 0 is found at position 0.
+func (bf *BitField) FindSetRun(count uint64) (uint64, bool) {
+	return bf.findRun(count, true)
+}
@@ -0,0 +1,498 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type FindTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Initial BitField for the test
+	expectedPos   uint64    // Expected position of the bit found
+	expectedFound bool      // Whether a bit is expected to be found
+}
+
+type FindNextSetTestCase struct {
+	name              string    // Name of the test case
+	bf                *BitField // Initial BitField for the test
+	expectedPositions []uint64  // Expected positions of all set bits, in ascending order
+}
+
+type FindRunTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Initial BitField for the test
+	count         uint64    // Length of the run to find
+	expectedPos   uint64    // Expected start of the run found
+	expectedFound bool      // Whether a run is expected to be found
+}
+
+// Test cases
+
+var findFirstSetTestCases = []FindTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name:          "All-zero BitField",
+		bf:            BigEndian.New(16),
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name: "First bit set LE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b00000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedPos:   0,
+		expectedFound: true,
+	},
+	{
+		name: "First bit set BE",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b00000000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPos:   0,
+		expectedFound: true,
+	},
+	{
+		name: "Set bit in second byte LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b01000100},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedPos:   10,
+		expectedFound: true,
+	},
+	{
+		name: "Set bit in second byte BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b01000100},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPos:   9,
+		expectedFound: true,
+	},
+	{
+		name: "Only padding bits set",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b00011111},
+			size:        11,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPos:   0,
+		expectedFound: false,
+	},
+}
+
+var findFirstClearTestCases = []FindTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name:          "All-zero BitField",
+		bf:            LittleEndian.New(16),
+		expectedPos:   0,
+		expectedFound: true,
+	},
+	{
+		name: "Clear bit in second byte LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11110111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedPos:   11,
+		expectedFound: true,
+	},
+	{
+		name: "Clear bit in second byte BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11110111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPos:   12,
+		expectedFound: true,
+	},
+	{
+		name: "Fully set 10-bit field LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000011},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name: "Fully set 10-bit field BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPos:   0,
+		expectedFound: false,
+	},
+}
+
+var findNextSetTestCases = []FindNextSetTestCase{
+	{
+		name:              "Empty BitField",
+		bf:                LittleEndian.New(0),
+		expectedPositions: nil,
+	},
+	{
+		name:              "All-zero BitField",
+		bf:                LittleEndian.New(24),
+		expectedPositions: nil,
+	},
+	{
+		name: "Sparse field LE",
+		bf: &BitField{
+			data:        []byte{0b00100001, 0b00000000, 0b10000010},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		expectedPositions: []uint64{0, 5, 17, 23},
+	},
+	{
+		name: "Sparse field BE",
+		bf: &BitField{
+			data:        []byte{0b00100001, 0b00000000, 0b10000010},
+			size:        24,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPositions: []uint64{2, 7, 16, 22},
+	},
+	{
+		name: "Adjacent bits within a byte",
+		bf: &BitField{
+			data:        []byte{0b00011000},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedPositions: []uint64{3, 4},
+	},
+	{
+		name: "Padding bits are skipped",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11111010},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedPositions: []uint64{9, 11},
+	},
+}
+
+var findClearRunTestCases = []FindRunTestCase{
+	{
+		name:          "All-zero BitField",
+		bf:            LittleEndian.New(20),
+		count:         20,
+		expectedPos:   0,
+		expectedFound: true,
+	},
+	{
+		name:          "Zero count",
+		bf:            LittleEndian.FromBytes([]byte{0xFF}, 8),
+		count:         0,
+		expectedPos:   0,
+		expectedFound: true,
+	},
+	{
+		name: "Run spanning a byte boundary LE",
+		bf: &BitField{
+			data:        []byte{0b00011111, 0b11111000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		count:         6,
+		expectedPos:   5,
+		expectedFound: true,
+	},
+	{
+		name: "Run spanning a byte boundary BE",
+		bf: &BitField{
+			data:        []byte{0b11111000, 0b00011111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		count:         6,
+		expectedPos:   5,
+		expectedFound: true,
+	},
+	{
+		name: "Run spanning several bytes after full bytes LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b01111111, 0b00000000, 0b11111100},
+			size:        32,
+			manipulator: LittleEndian,
+		},
+		count:         11,
+		expectedPos:   15,
+		expectedFound: true,
+	},
+	{
+		name: "Earlier run too short BE",
+		bf: &BitField{
+			data:        []byte{0b10001000, 0b00101111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		count:         4,
+		expectedPos:   5,
+		expectedFound: true,
+	},
+	{
+		name: "Run ending at the last bit",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000001},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		count:         3,
+		expectedPos:   9,
+		expectedFound: true,
+	},
+	{
+		name: "Padding bits do not extend a run LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000001},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		count:         4,
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name: "Padding bits do not extend a run BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b10000000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		count:         4,
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name:          "No room in a full BitField",
+		bf:            BigEndian.FromBytes([]byte{0xFF, 0xFF}, 16),
+		count:         1,
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name:          "Count larger than size",
+		bf:            LittleEndian.New(8),
+		count:         9,
+		expectedPos:   0,
+		expectedFound: false,
+	},
+}
+
+var findSetRunTestCases = []FindRunTestCase{
+	{
+		name:          "All-zero BitField",
+		bf:            BigEndian.New(16),
+		count:         1,
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name:          "Fully set BitField",
+		bf:            LittleEndian.FromBytes([]byte{0xFF, 0xFF}, 16),
+		count:         16,
+		expectedPos:   0,
+		expectedFound: true,
+	},
+	{
+		name: "Run starting mid-byte LE",
+		bf: &BitField{
+			data:        []byte{0b11101000, 0b00000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		count:         3,
+		expectedPos:   5,
+		expectedFound: true,
+	},
+	{
+		name: "Run starting mid-byte BE",
+		bf: &BitField{
+			data:        []byte{0b00010111, 0b00000000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		count:         3,
+		expectedPos:   5,
+		expectedFound: true,
+	},
+	{
+		name: "Run starting mid-byte and spanning full bytes LE",
+		bf: &BitField{
+			data:        []byte{0b11000000, 0b11111111, 0b00000111},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		count:         13,
+		expectedPos:   6,
+		expectedFound: true,
+	},
+	{
+		name: "Earlier run too short BE",
+		bf: &BitField{
+			data:        []byte{0b01100011, 0b11000000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		count:         3,
+		expectedPos:   6,
+		expectedFound: true,
+	},
+	{
+		name: "Run cut short by the size",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11111110},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		count:         4,
+		expectedPos:   0,
+		expectedFound: false,
+	},
+	{
+		name: "Run ending at the last bit BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b01110000},
+			size:        12,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		count:         3,
+		expectedPos:   9,
+		expectedFound: true,
+	},
+}
+
+// Test functions
+
+func TestFindFirstSet(t *testing.T) {
+	for _, tc := range findFirstSetTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pos, found := tc.bf.FindFirstSet()
+			if found != tc.expectedFound || pos != tc.expectedPos {
+				t.Errorf("FindFirstSet() got (%d, %t), want (%d, %t)", pos, found, tc.expectedPos, tc.expectedFound)
+				return
+			}
+
+			// The position found must agree with TestBit
+			if found {
+				if bit, err := tc.bf.TestBit(pos); err != nil || !bit {
+					t.Errorf("TestBit(%d) got (%t, %v), want (true, nil)", pos, bit, err)
+				}
+			}
+		})
+	}
+}
+
+func TestFindFirstClear(t *testing.T) {
+	for _, tc := range findFirstClearTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pos, found := tc.bf.FindFirstClear()
+			if found != tc.expectedFound || pos != tc.expectedPos {
+				t.Errorf("FindFirstClear() got (%d, %t), want (%d, %t)", pos, found, tc.expectedPos, tc.expectedFound)
+				return
+			}
+
+			// The position found must agree with TestBit
+			if found {
+				if bit, err := tc.bf.TestBit(pos); err != nil || bit {
+					t.Errorf("TestBit(%d) got (%t, %v), want (false, nil)", pos, bit, err)
+				}
+			}
+		})
+	}
+}
+
+func TestFindNextSet(t *testing.T) {
+	for _, tc := range findNextSetTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var positions []uint64
+			for i, ok := tc.bf.FindNextSet(0); ok; i, ok = tc.bf.FindNextSet(i + 1) {
+				positions = append(positions, i)
+			}
+			if !reflect.DeepEqual(positions, tc.expectedPositions) {
+				t.Errorf("FindNextSet() walk got %v, want %v", positions, tc.expectedPositions)
+			}
+		})
+	}
+}
+
+func TestFindNextSetOutOfRange(t *testing.T) {
+	bf := &BitField{
+		data:        []byte{0b11111111},
+		size:        8,
+		manipulator: LittleEndian,
+	}
+	if pos, found := bf.FindNextSet(8); found || pos != 0 {
+		t.Errorf("FindNextSet(8) got (%d, %t), want (0, false)", pos, found)
+	}
+}
+
+func runFindRunTest(t *testing.T, name string, find func(bf *BitField, count uint64) (uint64, bool),
+	inRange func(bf *BitField, offset, count uint64) (bool, error), tc FindRunTestCase) {
+	pos, found := find(tc.bf, tc.count)
+	if pos != tc.expectedPos || found != tc.expectedFound {
+		t.Errorf("%s(%d) got (%d, %t), want (%d, %t)", name, tc.count, pos, found, tc.expectedPos, tc.expectedFound)
+	}
+
+	// The run found consists of bits of the expected value only
+	if ok, err := inRange(tc.bf, pos, tc.count); found && (err != nil || !ok) {
+		t.Errorf("%s(%d) returned a run with a differing bit at %d: %v", name, tc.count, pos, err)
+	}
+}
+
+func TestFindClearRun(t *testing.T) {
+	for _, tc := range findClearRunTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runFindRunTest(t, "FindClearRun", (*BitField).FindClearRun, (*BitField).NoneInRange, tc)
+		})
+	}
+}
+
+func TestFindSetRun(t *testing.T) {
+	for _, tc := range findSetRunTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runFindRunTest(t, "FindSetRun", (*BitField).FindSetRun, (*BitField).AllInRange, tc)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package bitfield
+
+import (
+	"math/bits"
+)
+
+// ShiftLeft shifts every bit of the BitField n positions towards higher positions in place.
+// Vacated low positions are filled with 0 and bits shifted beyond the size of the BitField are discarded.
+func (bf *BitField) ShiftLeft(n uint64) error {
+	if bf.err == nil {
+		bf.shiftLeft(n)
+	}
+	return bf.err
+}
+
+// shiftLeft moves whole bytes n/8 places and then shifts the remaining n%8 bits across byte boundaries,
+// working on normalized bytes so that a higher position always means a more significant bit.
+func (bf *BitField) shiftLeft(n uint64) {
+	length := (bf.size + 7) / 8
+	if n >= bf.size {
+		clear(bf.data[:length])
+		return
+	}
+
+	byteShift, bitShift := n/8, n%8
+	for i := length; i > 0; i-- {
+		dst := i - 1
+		var b byte
+		if dst >= byteShift {
+			b = bf.normalize(bf.maskedByte(dst-byteShift)) << bitShift
+			if bitShift > 0 && dst > byteShift {
+				b |= bf.normalize(bf.maskedByte(dst-byteShift-1)) >> (8 - bitShift)
+			}
+		}
+		bf.data[dst] = bf.normalize(b)
+	}
+	bf.clearPadding()
+}
+
+// ShiftRight shifts every bit of the BitField n positions towards lower positions in place.
+// Vacated high positions are filled with 0 and bits shifted below position 0 are discarded.
+func (bf *BitField) ShiftRight(n uint64) error {
+	if bf.err == nil {
+		bf.shiftRight(n)
+	}
+	return bf.err
+}
+
+// shiftRight is the counterpart of shiftLeft. Padding bits are masked off before they
+// are shifted so that they never become part of the logical bits.
+func (bf *BitField) shiftRight(n uint64) {
+	length := (bf.size + 7) / 8
+	if n >= bf.size {
+		clear(bf.data[:length])
+		return
+	}
+
+	byteShift, bitShift := n/8, n%8
+	for dst := uint64(0); dst < length; dst++ {
+		var b byte
+		if src := dst + byteShift; src < length {
+			b = bf.normalize(bf.maskedByte(src)) >> bitShift
+			if bitShift > 0 && src+1 < length {
+				b |= bf.normalize(bf.maskedByte(src+1)) << (8 - bitShift)
+			}
+		}
+		bf.data[dst] = bf.normalize(b)
+	}
+	bf.clearPadding()
+}
+
+// RotateLeft rotates every bit of the BitField n positions towards higher positions in place.
+// Bits shifted beyond the size of the BitField wrap around to the lowest positions.
+func (bf *BitField) RotateLeft(n uint64) error {
+	if bf.err == nil && bf.size > 0 {
+		bf.rotateLeft(n % bf.size)
+	}
+	return bf.err
+}
+
+// RotateRight rotates every bit of the BitField n positions towards lower positions in place.
+// Bits shifted below position 0 wrap around to the highest positions.
+func (bf *BitField) RotateRight(n uint64) error {
+	if bf.err == nil && bf.size > 0 {
+		bf.rotateLeft(bf.size - n%bf.size)
+	}
+	return bf.err
+}
+
+// rotateLeft combines a left shift by n with a right shift by size-n of a copy,
+// so that the wrap-around happens at the size boundary rather than at the end of the data.
+func (bf *BitField) rotateLeft(n uint64) {
+	if n == 0 || n == bf.size {
+		return
+	}
+
+	wrapped := bf.Clone()
+	wrapped.shiftRight(bf.size - n)
+	bf.shiftLeft(n)
+
+	for i := range wrapped.data {
+		bf.data[i] |= wrapped.data[i]
+	}
+}
+
+// Reverse reverses the order of the bits of the BitField in place, so that position i
+// swaps with position size-1-i.
+func (bf *BitField) Reverse() error {
+	if bf.err == nil {
+		bf.reverse()
+	}
+	return bf.err
+}
+
+// reverse reverses the order of the bytes and of the bits within every byte, which mirrors the
+// positions over the byte-aligned length, and then shifts out the positions that came from padding.
+func (bf *BitField) reverse() {
+	length := (bf.size + 7) / 8
+	reversed := bf.sibling(length * 8)
+	for i := uint64(0); i < length; i++ {
+		reversed.data[length-1-i] = bits.Reverse8(bf.maskedByte(i))
+	}
+	reversed.shiftRight(length*8 - bf.size)
+	copy(bf.data, reversed.data)
+}
@@ -0,0 +1,429 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type ShiftTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	n            uint64    // The number of positions to shift by
+	expectedBits []byte    // Expected byte slice after shifting
+}
+
+type ReverseTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	expectedBits []byte    // Expected byte slice after reversing
+}
+
+// Test cases
+
+var shiftLeftTestCases = []ShiftTestCase{
+	{
+		name: "Shift by zero",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		n:            0,
+		expectedBits: []byte{0b10110001, 0b01000011},
+	},
+	{
+		name: "Shift within a byte LE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		n:            3,
+		expectedBits: []byte{0b10001000, 0b00011101},
+	},
+	{
+		name: "Shift within a byte BE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            3,
+		expectedBits: []byte{0b00010110, 0b00101000},
+	},
+	{
+		name: "Shift across bytes LE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		n:            11,
+		expectedBits: []byte{0b00000000, 0b10001000},
+	},
+	{
+		name: "Shift across bytes BE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            11,
+		expectedBits: []byte{0b00000000, 0b00010110},
+	},
+	{
+		name: "Shift non-aligned field LE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000011},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		n:            4,
+		expectedBits: []byte{0b11110000, 0b00000011},
+	},
+	{
+		name: "Shift non-aligned field BE",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            4,
+		expectedBits: []byte{0b00001111, 0b11000000},
+	},
+	{
+		name: "Shift by size",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000011},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		n:            10,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Shift beyond size",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            100,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+}
+
+var shiftRightTestCases = []ShiftTestCase{
+	{
+		name: "Shift within a byte LE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		n:            3,
+		expectedBits: []byte{0b01110110, 0b00001000},
+	},
+	{
+		name: "Shift within a byte BE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            3,
+		expectedBits: []byte{0b10001010, 0b00011000},
+	},
+	{
+		name: "Shift across bytes LE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		n:            11,
+		expectedBits: []byte{0b00001000, 0b00000000},
+	},
+	{
+		name: "Shift across bytes BE",
+		bf: &BitField{
+			data:        []byte{0b10110001, 0b01000011},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            11,
+		expectedBits: []byte{0b00011000, 0b00000000},
+	},
+	{
+		name: "Padding bits stay out LE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11111110},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		n:            2,
+		expectedBits: []byte{0b10000000, 0b00000000},
+	},
+	{
+		name: "Padding bits stay out BE",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b01111111},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            2,
+		expectedBits: []byte{0b00000001, 0b00000000},
+	},
+	{
+		name: "Shift beyond size",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b00000011},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		n:            64,
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+}
+
+var rotateLeftTestCases = []ShiftTestCase{
+	{
+		name: "Rotate 13-bit field LE",
+		bf: &BitField{
+			data:        []byte{0b00000011, 0b00010000},
+			size:        13,
+			manipulator: LittleEndian,
+		},
+		n:            1,
+		expectedBits: []byte{0b00000111, 0b00000000},
+	},
+	{
+		name: "Rotate 13-bit field BE",
+		bf: &BitField{
+			data:        []byte{0b11000000, 0b00001000},
+			size:        13,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            1,
+		expectedBits: []byte{0b11100000, 0b00000000},
+	},
+	{
+		name: "Rotate by more than size",
+		bf: &BitField{
+			data:        []byte{0b00000011, 0b00010000},
+			size:        13,
+			manipulator: LittleEndian,
+		},
+		n:            14,
+		expectedBits: []byte{0b00000111, 0b00000000},
+	},
+	{
+		name: "Rotate across bytes LE",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b00000000},
+			size:        13,
+			manipulator: LittleEndian,
+		},
+		n:            9,
+		expectedBits: []byte{0b00000000, 0b00000010},
+	},
+}
+
+var rotateRightTestCases = []ShiftTestCase{
+	{
+		name: "Rotate 13-bit field LE",
+		bf: &BitField{
+			data:        []byte{0b00000011, 0b00010000},
+			size:        13,
+			manipulator: LittleEndian,
+		},
+		n:            1,
+		expectedBits: []byte{0b00000001, 0b00011000},
+	},
+	{
+		name: "Rotate 13-bit field BE",
+		bf: &BitField{
+			data:        []byte{0b11000000, 0b00001000},
+			size:        13,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            1,
+		expectedBits: []byte{0b10000000, 0b00011000},
+	},
+	{
+		name: "Rotate across bytes BE",
+		bf: &BitField{
+			data:        []byte{0b10000000, 0b00000000},
+			size:        13,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		n:            3,
+		expectedBits: []byte{0b00000000, 0b00100000},
+	},
+}
+
+var reverseTestCases = []ReverseTestCase{
+	{
+		name:         "Empty BitField",
+		bf:           LittleEndian.New(0),
+		expectedBits: []byte{},
+	},
+	{
+		name: "Byte-aligned field",
+		bf: &BitField{
+			data:        []byte{0b00000001, 0b11000000},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00000011, 0b10000000},
+	},
+	{
+		name: "Non-aligned field LE",
+		bf: &BitField{
+			data:        []byte{0b00001001, 0b00000000},
+			size:        10,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b01000000, 0b00000010},
+	},
+	{
+		name: "Non-aligned field BE",
+		bf: &BitField{
+			data:        []byte{0b10010000, 0b00000000},
+			size:        10,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		expectedBits: []byte{0b00000010, 0b01000000},
+	},
+	{
+		name: "Padding bits are ignored",
+		bf: &BitField{
+			data:        []byte{0b00000000, 0b11110001},
+			size:        12,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00001000, 0b00000000},
+	},
+	{
+		name: "Palindromic pattern",
+		bf: &BitField{
+			data:        []byte{0b00100101, 0b00000101},
+			size:        11,
+			manipulator: LittleEndian,
+		},
+		expectedBits: []byte{0b00100101, 0b00000101},
+	},
+}
+
+// Test functions
+
+func runShiftTest(t *testing.T, name string, op func(bf *BitField, n uint64) error, tc ShiftTestCase) {
+	if err := op(tc.bf, tc.n); err != nil {
+		t.Errorf("%s() returned unexpected error: %v", name, err)
+		return
+	}
+
+	if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+		t.Errorf("%s() got %v, want %v", name, tc.bf.data, tc.expectedBits)
+	}
+}
+
+func TestShiftLeft(t *testing.T) {
+	for _, tc := range shiftLeftTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runShiftTest(t, "ShiftLeft", (*BitField).ShiftLeft, tc)
+		})
+	}
+}
+
+func TestShiftRight(t *testing.T) {
+	for _, tc := range shiftRightTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runShiftTest(t, "ShiftRight", (*BitField).ShiftRight, tc)
+		})
+	}
+}
+
+func TestRotateLeft(t *testing.T) {
+	for _, tc := range rotateLeftTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runShiftTest(t, "RotateLeft", (*BitField).RotateLeft, tc)
+		})
+	}
+}
+
+func TestRotateRight(t *testing.T) {
+	for _, tc := range rotateRightTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runShiftTest(t, "RotateRight", (*BitField).RotateRight, tc)
+		})
+	}
+}
+
+func TestRotateBySize(t *testing.T) {
+	originals := map[BitManipulator][]byte{
+		LittleEndian: {0b10110010, 0b00010101},
+		BigEndian:    {0b10110010, 0b10101000},
+	}
+	for m, original := range originals {
+		for _, n := range []uint64{0, 13, 26} {
+			left := m.FromBytes(original, 13)
+			left.RotateLeft(n)
+			if !reflect.DeepEqual(left.data, original) {
+				t.Errorf("RotateLeft(%d) got %v, want %v", n, left.data, original)
+			}
+
+			right := m.FromBytes(original, 13)
+			right.RotateRight(n)
+			if !reflect.DeepEqual(right.data, original) {
+				t.Errorf("RotateRight(%d) got %v, want %v", n, right.data, original)
+			}
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	for _, tc := range reverseTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.bf.Reverse(); err != nil {
+				t.Errorf("Reverse() returned unexpected error: %v", err)
+				return
+			}
+
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("Reverse() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestReverseTwice(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for _, size := range []uint64{1, 7, 8, 13, 24} {
+			original := []byte{0b10110010, 0b01101001, 0b11100100}
+			bf := m.FromBytes(original, size)
+			bf.clearPadding()
+			expected := bf.Bytes()
+
+			bf.Reverse()
+			bf.Reverse()
+
+			if !reflect.DeepEqual(bf.data, expected) {
+				t.Errorf("Reverse() twice with size %d got %v, want %v", size, bf.data, expected)
+			}
+		}
+	}
+}
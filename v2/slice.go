@@ -0,0 +1,102 @@
+package bitfield
+
+import (
+	"fmt"
+)
+
+// Slice returns a new BitField of count bits holding the bits [offset, offset+count) of the BitField,
+// so that position 0 of the result is position offset of the source. The result uses the same manipulator.
+// Returns an error if the range goes beyond the bounds of the BitField.
+func (bf *BitField) Slice(offset, count uint64) (*BitField, error) {
+	if bf.err != nil {
+		return nil, bf.err
+	}
+	if err := bf.checkRange(offset, count); err != nil {
+		return nil, err
+	}
+
+	result := bf.sibling(count)
+	copyBits(result, 0, bf, offset, count)
+	return result, nil
+}
+
+// InsertBytes copies count bits from src into the BitField starting at offset.
+// The bits of src are interpreted using the manipulator of the BitField, so src is read as if it were
+// the underlying data of a BitField with the same manipulator.
+// Returns an error if the operation goes beyond the bounds of the BitField or if src holds fewer than count bits.
+func (bf *BitField) InsertBytes(offset uint64, src []byte, count uint64) error {
+	if bf.err == nil {
+		bf.err = bf.insertBytes(offset, src, count)
+	}
+	return bf.err
+}
+
+func (bf *BitField) insertBytes(offset uint64, src []byte, count uint64) error {
+	if err := bf.checkRange(offset, count); err != nil {
+		return err
+	}
+	if count > uint64(len(src))*8 {
+		return fmt.Errorf("%w: source holds %d bits, want %d", ErrInvalidSize, len(src)*8, count)
+	}
+
+	source := &BitField{
+		data:        src,
+		size:        count,
+		manipulator: bf.manipulator,
+		msb0:        bf.msb0,
+	}
+	copyBits(bf, offset, source, 0, count)
+	return nil
+}
+
+// InsertField copies all bits of src into the BitField starting at offset, overwriting the bits already there.
+// The bits are copied position by position, so src may use a different manipulator.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) InsertField(offset uint64, src *BitField) error {
+	if bf.err == nil {
+		bf.err = bf.insertField(offset, src)
+	}
+	return bf.err
+}
+
+func (bf *BitField) insertField(offset uint64, src *BitField) error {
+	if err := bf.checkRange(offset, src.size); err != nil {
+		return err
+	}
+
+	copyBits(bf, offset, src, 0, src.size)
+	return nil
+}
+
+// ExtractBytes returns count bits of the BitField starting at offset, packed into a byte slice using the
+// manipulator of the BitField. The padding bits of the final byte are cleared.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) ExtractBytes(offset, count uint64) ([]byte, error) {
+	slice, err := bf.Slice(offset, count)
+	if err != nil {
+		return nil, err
+	}
+	return slice.data, nil
+}
+
+// SplitEvery extracts the BitField as consecutive width-bit values from position 0, in the same bit order as
+// ExtractUint64. If the size is not a multiple of width, the final value holds the remaining bits, right-justified.
+// Returns an error if width is 0 or larger than 64.
+func (bf *BitField) SplitEvery(width uint64) ([]uint64, error) {
+	if width == 0 {
+		return nil, fmt.Errorf("%w: width is 0", ErrInvalidSize)
+	}
+	if width > 64 {
+		return nil, fmt.Errorf("%w: got %d", ErrSizeTooLarge, width)
+	}
+
+	values := make([]uint64, 0, (bf.size+width-1)/width)
+	for offset := uint64(0); offset < bf.size; offset += width {
+		v, err := bf.ExtractUint64(offset, min(width, bf.size-offset))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
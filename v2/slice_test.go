@@ -0,0 +1,432 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type SliceTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	offset       uint64    // The starting position of the slice
+	count        uint64    // The number of bits in the slice
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice of the result
+}
+
+type InsertBytesTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	offset       uint64    // The position to insert at
+	src          []byte    // The bytes to insert
+	count        uint64    // The number of bits to insert
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice after inserting
+}
+
+type InsertFieldTestCase struct {
+	name         string    // Name of the test case
+	bf           *BitField // Initial BitField for the test
+	offset       uint64    // The position to insert at
+	src          *BitField // The BitField to insert
+	expectError  bool      // Whether an error is expected
+	expectedBits []byte    // Expected byte slice after inserting
+}
+
+type SplitEveryTestCase struct {
+	name           string    // Name of the test case
+	bf             *BitField // BitField to split
+	width          uint64    // Number of bits in each value
+	expectError    bool      // Whether an error is expected
+	expectedValues []uint64  // Expected values, in order of position
+}
+
+// Test cases
+
+var sliceTestCases = []SliceTestCase{
+	{
+		name: "Slice starting and ending mid-byte LE",
+		bf: &BitField{
+			data:        []byte{0b10110000, 0b00000101},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		offset:       4,
+		count:        7,
+		expectedBits: []byte{0b01011011},
+	},
+	{
+		name: "Slice starting and ending mid-byte BE",
+		bf: &BitField{
+			data:        []byte{0b00001101, 0b10100000},
+			size:        16,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:       4,
+		count:        7,
+		expectedBits: []byte{0b11011010},
+	},
+	{
+		name: "Slice spanning three bytes LE",
+		bf: &BitField{
+			data:        []byte{0b11000000, 0b10101010, 0b00000001},
+			size:        24,
+			manipulator: LittleEndian,
+		},
+		offset:       6,
+		count:        11,
+		expectedBits: []byte{0b10101011, 0b00000110},
+	},
+	{
+		name: "Whole field",
+		bf: &BitField{
+			data:        []byte{0b10100000},
+			size:        3,
+			manipulator: BigEndian,
+			msb0:        true,
+		},
+		offset:       0,
+		count:        3,
+		expectedBits: []byte{0b10100000},
+	},
+	{
+		name:         "Empty slice",
+		bf:           LittleEndian.New(8),
+		offset:       8,
+		count:        0,
+		expectedBits: []byte{},
+	},
+	{
+		name:        "Slice beyond size",
+		bf:          LittleEndian.New(16),
+		offset:      10,
+		count:       7,
+		expectError: true,
+	},
+}
+
+var insertBytesTestCases = []InsertBytesTestCase{
+	{
+		name:         "Insert whole bytes at aligned offset",
+		bf:           LittleEndian.New(24),
+		offset:       8,
+		src:          []byte{0b10101010, 0b11001100},
+		count:        16,
+		expectedBits: []byte{0b00000000, 0b10101010, 0b11001100},
+	},
+	{
+		name:         "Insert partial byte at ragged offset LE",
+		bf:           LittleEndian.New(16),
+		offset:       5,
+		src:          []byte{0b11110101},
+		count:        5,
+		expectedBits: []byte{0b10100000, 0b00000010}, // Only the low 5 bits of the source are used
+	},
+	{
+		name:         "Insert partial byte at ragged offset BE",
+		bf:           BigEndian.New(16),
+		offset:       5,
+		src:          []byte{0b10101111},
+		count:        5,
+		expectedBits: []byte{0b00000101, 0b01000000}, // Only the first 5 bits of the source are used
+	},
+	{
+		name: "Insert preserves surrounding bits",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		offset:       4,
+		src:          []byte{0b00000000},
+		count:        8,
+		expectedBits: []byte{0b00001111, 0b11110000},
+	},
+	{
+		name:        "Insert beyond size",
+		bf:          LittleEndian.New(16),
+		offset:      10,
+		src:         []byte{0b11111111},
+		count:       8,
+		expectError: true,
+	},
+	{
+		name:        "Source too short",
+		bf:          LittleEndian.New(32),
+		offset:      0,
+		src:         []byte{0b11111111},
+		count:       9,
+		expectError: true,
+	},
+}
+
+var insertFieldTestCases = []InsertFieldTestCase{
+	{
+		name:         "Insert 5 bits at offset 3 LE",
+		bf:           LittleEndian.New(16),
+		offset:       3,
+		src:          LittleEndian.FromBytes([]byte{0b00010110}, 5), // Positions 1, 2 and 4 set
+		expectedBits: []byte{0b10110000, 0b00000000},
+	},
+	{
+		name:         "Insert 5 bits at offset 3 BE",
+		bf:           BigEndian.New(16),
+		offset:       3,
+		src:          BigEndian.FromBytes([]byte{0b01101000}, 5), // Positions 1, 2 and 4 set
+		expectedBits: []byte{0b00001101, 0b00000000},
+	},
+	{
+		name:         "Insert across a byte boundary LE",
+		bf:           LittleEndian.New(16),
+		offset:       6,
+		src:          LittleEndian.FromBytes([]byte{0b00010110}, 5),
+		expectedBits: []byte{0b10000000, 0b00000101},
+	},
+	{
+		name:         "Insert across a byte boundary BE",
+		bf:           BigEndian.New(16),
+		offset:       6,
+		src:          BigEndian.FromBytes([]byte{0b01101000}, 5),
+		expectedBits: []byte{0b00000001, 0b10100000},
+	},
+	{
+		name:         "Source with a different manipulator",
+		bf:           BigEndian.New(16),
+		offset:       3,
+		src:          LittleEndian.FromBytes([]byte{0b00010110}, 5),
+		expectedBits: []byte{0b00001101, 0b00000000},
+	},
+	{
+		name:         "Padding bits of the source are ignored",
+		bf:           LittleEndian.New(16),
+		offset:       0,
+		src:          LittleEndian.FromBytes([]byte{0b11100000}, 5),
+		expectedBits: []byte{0b00000000, 0b00000000},
+	},
+	{
+		name: "Insert overwrites and preserves surrounding bits",
+		bf: &BitField{
+			data:        []byte{0b11111111, 0b11111111},
+			size:        16,
+			manipulator: LittleEndian,
+		},
+		offset:       3,
+		src:          LittleEndian.New(5),
+		expectedBits: []byte{0b00000111, 0b11111111},
+	},
+	{
+		name:        "Insert beyond size",
+		bf:          LittleEndian.New(16),
+		offset:      12,
+		src:         LittleEndian.New(5),
+		expectError: true,
+	},
+}
+
+var splitEveryTestCases = []SplitEveryTestCase{
+	{
+		name:           "Empty BitField",
+		bf:             BigEndian.New(0),
+		width:          6,
+		expectedValues: []uint64{},
+	},
+	{
+		name:           "Size a multiple of width BE",
+		bf:             BigEndian.FromBytes([]byte{0b10110100, 0b11010000}, 12),
+		width:          6,
+		expectedValues: []uint64{0b101101, 0b001101},
+	},
+	{
+		name:           "Size not a multiple of width BE",
+		bf:             BigEndian.FromBytes([]byte{0b10110100, 0b11011100}, 14),
+		width:          6,
+		expectedValues: []uint64{0b101101, 0b001101, 0b11}, // The final 2 bits are right-justified
+	},
+	{
+		name:           "Size a multiple of width LE",
+		bf:             LittleEndian.FromBytes([]byte{0x34, 0x02}, 12),
+		width:          4,
+		expectedValues: []uint64{0x4, 0x3, 0x2},
+	},
+	{
+		name:           "Size not a multiple of width LE",
+		bf:             LittleEndian.FromBytes([]byte{0x34, 0x02}, 10),
+		width:          4,
+		expectedValues: []uint64{0x4, 0x3, 0b10},
+	},
+	{
+		name:           "Width larger than size",
+		bf:             LittleEndian.FromBytes([]byte{0xFF, 0x01}, 9),
+		width:          16,
+		expectedValues: []uint64{0x1FF},
+	},
+	{
+		name:           "Full 64-bit width",
+		bf:             BigEndian.FromBytes([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, 72),
+		width:          64,
+		expectedValues: []uint64{0x0102030405060708, 0x09},
+	},
+	{
+		name:        "Zero width",
+		bf:          LittleEndian.New(8),
+		width:       0,
+		expectError: true,
+	},
+	{
+		name:        "Width larger than 64",
+		bf:          LittleEndian.New(128),
+		width:       65,
+		expectError: true,
+	},
+}
+
+// Test functions
+
+func TestSlice(t *testing.T) {
+	for _, tc := range sliceTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.bf.Slice(tc.offset, tc.count)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("Slice() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if tc.expectError {
+				return
+			}
+
+			if result.size != tc.count {
+				t.Errorf("Slice() got size %d, want %d", result.size, tc.count)
+			}
+			if result.manipulator != tc.bf.manipulator {
+				t.Errorf("Slice() got manipulator %v, want %v", result.manipulator, tc.bf.manipulator)
+			}
+			if !reflect.DeepEqual(result.data, tc.expectedBits) {
+				t.Errorf("Slice() got %v, want %v", result.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestInsertBytes(t *testing.T) {
+	for _, tc := range insertBytesTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bf.InsertBytes(tc.offset, tc.src, tc.count)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("InsertBytes() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if !tc.expectError && !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("InsertBytes() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestInsertField(t *testing.T) {
+	for _, tc := range insertFieldTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bf.InsertField(tc.offset, tc.src)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("InsertField() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if !tc.expectError && !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("InsertField() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestInsertBytesHash(t *testing.T) {
+	hash := []byte{
+		0xda, 0x39, 0xa3, 0xee, 0x5e, 0x6b, 0x4b, 0x0d, 0x32, 0x55,
+		0xbf, 0xef, 0x95, 0x60, 0x18, 0x90, 0xaf, 0xd8, 0x07, 0x09,
+	}
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(176)
+		if err := bf.InsertBytes(3, hash, 160); err != nil {
+			t.Errorf("InsertBytes() returned unexpected error: %v", err)
+			continue
+		}
+
+		slice, _ := bf.Slice(3, 160)
+		if !reflect.DeepEqual(slice.data, hash) {
+			t.Errorf("InsertBytes() got %x, want %x", slice.data, hash)
+		}
+		if bf.OnesCount() != slice.OnesCount() {
+			t.Errorf("InsertBytes() wrote bits outside of the range")
+		}
+	}
+}
+
+func TestExtractBytes(t *testing.T) {
+	expectedBytes := map[BitManipulator][]byte{
+		LittleEndian: {0b11111111, 0b00000011},
+		BigEndian:    {0b11111111, 0b11000000},
+	}
+	for m, expected := range expectedBytes {
+		bf := m.FromBytes([]byte{0b11111111, 0b11111111, 0b11111111}, 24)
+
+		// The unused positions of the final byte must be zero-padded
+		value, err := bf.ExtractBytes(2, 10)
+		if err != nil {
+			t.Errorf("ExtractBytes() returned unexpected error: %v", err)
+			continue
+		}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("ExtractBytes() got %v, want %v", value, expected)
+		}
+
+		if _, err := bf.ExtractBytes(20, 5); err == nil {
+			t.Errorf("ExtractBytes() beyond size expected an error, but got none")
+		}
+	}
+}
+
+func TestInsertExtractBytesRoundTrip(t *testing.T) {
+	src := []byte{0b10110011, 0b01011100, 0b11100001, 0b00000110}
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for _, tc := range []struct{ offset, count uint64 }{{0, 32}, {3, 32}, {7, 25}, {13, 8}} {
+			bf := m.New(64)
+			bf.InsertBytes(tc.offset, src, tc.count)
+
+			value, err := bf.ExtractBytes(tc.offset, tc.count)
+			if err != nil {
+				t.Errorf("ExtractBytes() returned unexpected error: %v", err)
+				continue
+			}
+
+			expected := m.FromBytes(src[:(tc.count+7)/8], tc.count)
+			expected.clearPadding()
+			if !reflect.DeepEqual(value, expected.data) {
+				t.Errorf("ExtractBytes(%d, %d) got %v, want %v", tc.offset, tc.count, value, expected.data)
+			}
+		}
+	}
+}
+
+func TestSplitEvery(t *testing.T) {
+	for _, tc := range splitEveryTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values, err := tc.bf.SplitEvery(tc.width)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("SplitEvery() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if !reflect.DeepEqual(values, tc.expectedValues) {
+				t.Errorf("SplitEvery() got %v, want %v", values, tc.expectedValues)
+			}
+		})
+	}
+}
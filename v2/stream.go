@@ -0,0 +1,35 @@
+package bitfield
+
+import (
+	"io"
+)
+
+// WriteTo implements io.WriterTo by writing the underlying data of the BitField to w with the padding bits cleared.
+// It returns the number of bytes written and any error returned by w.
+func (bf *BitField) WriteTo(w io.Writer) (int64, error) {
+	n := (bf.size + 7) / 8
+	if n == 0 {
+		return 0, nil
+	}
+
+	// Only the final byte can hold padding bits, so the other bytes are written without copying them.
+	written, err := w.Write(bf.data[:n-1])
+	if err != nil {
+		return int64(written), err
+	}
+	last, err := w.Write([]byte{bf.maskedByte(n - 1)})
+	return int64(written + last), err
+}
+
+// ReadFrom reads exactly the ceil(size/8) bytes needed for size bits from r and returns them as a new BitField
+// that uses the manipulator m. It returns io.ErrUnexpectedEOF if r holds fewer bytes, even if it holds none.
+func ReadFrom(r io.Reader, size uint64, m BitManipulator) (*BitField, error) {
+	bf := m.New(size)
+	if _, err := io.ReadFull(r, bf.data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return bf, nil
+}
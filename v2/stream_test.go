@@ -0,0 +1,199 @@
+package bitfield
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// Compile-time check to ensure BitField implements io.WriterTo
+var _ io.WriterTo = &BitField{}
+
+// Mocks
+
+type failingWriter struct {
+	limit int // Number of bytes accepted before failing
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errors.New("mock error")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+// Test case structs
+
+type WriteToTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // BitField to write
+	expectedBytes []byte    // Expected bytes written
+}
+
+type ReadFromTestCase struct {
+	name         string         // Name of the test case
+	input        []byte         // Bytes available from the reader
+	size         uint64         // Size of the BitField in bits
+	manipulator  BitManipulator // Manipulator of the BitField
+	expectError  error          // Expected error, if any
+	expectedBits []byte         // Expected byte slice of the BitField
+}
+
+// Test cases
+
+var writeToTestCases = []WriteToTestCase{
+	{
+		name:          "Empty BitField",
+		bf:            LittleEndian.New(0),
+		expectedBytes: []byte{},
+	},
+	{
+		name:          "Byte-aligned field",
+		bf:            BigEndian.FromBytes([]byte{0x12, 0x34, 0x56}, 24),
+		expectedBytes: []byte{0x12, 0x34, 0x56},
+	},
+	{
+		name:          "Padding cleared LE",
+		bf:            LittleEndian.FromBytes([]byte{0xFF, 0xFF}, 10),
+		expectedBytes: []byte{0xFF, 0b00000011},
+	},
+	{
+		name:          "Padding cleared BE",
+		bf:            BigEndian.FromBytes([]byte{0xFF, 0xFF}, 10),
+		expectedBytes: []byte{0xFF, 0b11000000},
+	},
+}
+
+var readFromTestCases = []ReadFromTestCase{
+	{
+		name:         "Exact read",
+		input:        []byte{0x12, 0x34},
+		size:         16,
+		manipulator:  BigEndian,
+		expectedBits: []byte{0x12, 0x34},
+	},
+	{
+		name:         "Remaining bytes are not read",
+		input:        []byte{0x12, 0x34, 0x56},
+		size:         16,
+		manipulator:  LittleEndian,
+		expectedBits: []byte{0x12, 0x34},
+	},
+	{
+		name:         "Non-byte-aligned size",
+		input:        []byte{0xFF, 0x03},
+		size:         10,
+		manipulator:  LittleEndian,
+		expectedBits: []byte{0xFF, 0x03},
+	},
+	{
+		name:         "Zero size",
+		input:        []byte{},
+		size:         0,
+		manipulator:  LittleEndian,
+		expectedBits: []byte{},
+	},
+	{
+		name:        "Short read",
+		input:       []byte{0xFF},
+		size:        10,
+		manipulator: BigEndian,
+		expectError: io.ErrUnexpectedEOF,
+	},
+	{
+		name:        "Empty reader",
+		input:       []byte{},
+		size:        1,
+		manipulator: LittleEndian,
+		expectError: io.ErrUnexpectedEOF,
+	},
+}
+
+// Test functions
+
+func TestWriteTo(t *testing.T) {
+	for _, tc := range writeToTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			n, err := tc.bf.WriteTo(&buf)
+
+			if err != nil {
+				t.Fatalf("WriteTo() returned unexpected error: %v", err)
+			}
+			if n != int64(len(tc.expectedBytes)) {
+				t.Errorf("WriteTo() got %d bytes written, want %d", n, len(tc.expectedBytes))
+			}
+			if !bytes.Equal(buf.Bytes(), tc.expectedBytes) {
+				t.Errorf("WriteTo() got %v, want %v", buf.Bytes(), tc.expectedBytes)
+			}
+
+			// Once the padding is cleared, the written bytes match those returned by Bytes
+			tc.bf.Canonicalize()
+			if !bytes.Equal(buf.Bytes(), tc.bf.Bytes()) {
+				t.Errorf("WriteTo() got %v, want Bytes() %v", buf.Bytes(), tc.bf.Bytes())
+			}
+		})
+	}
+}
+
+func TestWriteToError(t *testing.T) {
+	bf := LittleEndian.FromBytes([]byte{0x01, 0x02, 0x03}, 20)
+
+	for _, limit := range []int{0, 1, 2} {
+		n, err := bf.WriteTo(&failingWriter{limit: limit})
+		if err == nil {
+			t.Errorf("WriteTo() returned no error with limit %d, want mock error", limit)
+		}
+		if n != int64(limit) {
+			t.Errorf("WriteTo() got %d bytes written with limit %d, want %d", n, limit, limit)
+		}
+	}
+}
+
+func TestReadFrom(t *testing.T) {
+	for _, tc := range readFromTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bf, err := ReadFrom(bytes.NewReader(tc.input), tc.size, tc.manipulator)
+
+			if !errors.Is(err, tc.expectError) {
+				t.Fatalf("ReadFrom() returned unexpected error: got %v, want %v", err, tc.expectError)
+			}
+			if tc.expectError != nil {
+				return
+			}
+
+			if !bytes.Equal(bf.data, tc.expectedBits) {
+				t.Errorf("ReadFrom() got %v, want %v", bf.data, tc.expectedBits)
+			}
+			if bf.size != tc.size {
+				t.Errorf("ReadFrom() got size %d, want %d", bf.size, tc.size)
+			}
+			if bf.manipulator != tc.manipulator {
+				t.Errorf("ReadFrom() got manipulator %v, want %v", bf.manipulator, tc.manipulator)
+			}
+		})
+	}
+}
+
+func TestWriteToReadFromRoundTrip(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		original := m.FromBytes([]byte{0b10110011, 0b01011100}, 13)
+
+		var buf bytes.Buffer
+		if _, err := original.WriteTo(&buf); err != nil {
+			t.Fatalf("WriteTo() returned unexpected error: %v", err)
+		}
+		decoded, err := ReadFrom(&buf, 13, m)
+		if err != nil {
+			t.Fatalf("ReadFrom() returned unexpected error: %v", err)
+		}
+
+		if !decoded.Equal(original) {
+			t.Errorf("ReadFrom() got %v, want %v", decoded, original)
+		}
+	}
+}
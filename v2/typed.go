@@ -0,0 +1,140 @@
+package bitfield
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// InsertUint8 sets the 8 bits starting at offset to the value v.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) InsertUint8(offset uint64, v uint8) error {
+	return bf.InsertUint64(offset, 8, uint64(v))
+}
+
+// InsertUint16 sets the 16 bits starting at offset to the value v.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) InsertUint16(offset uint64, v uint16) error {
+	return bf.InsertUint64(offset, 16, uint64(v))
+}
+
+// InsertUint32 sets the 32 bits starting at offset to the value v.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) InsertUint32(offset uint64, v uint32) error {
+	return bf.InsertUint64(offset, 32, uint64(v))
+}
+
+// InsertUint64Auto sets the bits starting at offset to value, using the minimum number of bits needed to
+// represent it, and returns that number. A zero value consumes no bits.
+// Returns an error, and consumes no bits, if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) InsertUint64Auto(offset, value uint64) (uint64, error) {
+	size := uint64(bits.Len64(value))
+	if err := bf.InsertUint64(offset, size, value); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// ExtractUint8 retrieves the 8 bits starting at offset as a uint8 value.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) ExtractUint8(offset uint64) (uint8, error) {
+	v, err := bf.ExtractUint64(offset, 8)
+	return uint8(v), err
+}
+
+// ExtractUint16 retrieves the 16 bits starting at offset as a uint16 value.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) ExtractUint16(offset uint64) (uint16, error) {
+	v, err := bf.ExtractUint64(offset, 16)
+	return uint16(v), err
+}
+
+// ExtractUint32 retrieves the 32 bits starting at offset as a uint32 value.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) ExtractUint32(offset uint64) (uint32, error) {
+	v, err := bf.ExtractUint64(offset, 32)
+	return uint32(v), err
+}
+
+// ExtractUint64Aligned retrieves the bitWidth bits starting at the byte boundary bytePos*8 as a uint64 value,
+// using the manipulator's bit order. This matches fixed-layout headers whose fields start on whole bytes.
+// Returns an error if the operation goes beyond the bounds of the BitField or bitWidth is larger than 64.
+func (bf *BitField) ExtractUint64Aligned(bytePos, bitWidth uint64) (uint64, error) {
+	if bf.err != nil {
+		return 0, bf.err
+	}
+	if bytePos > bf.size/8 {
+		return 0, fmt.Errorf("%w: byte %d, size %d", ErrOutOfRange, bytePos, bf.size)
+	}
+	return bf.ExtractUint64(bytePos*8, bitWidth)
+}
+
+// ExtractUint64FromEnd retrieves the size bits that end bitsFromEnd bits before the end of the BitField,
+// i.e. starting at offset Size()-bitsFromEnd-size, as a uint64 value in the same bit order as ExtractUint64.
+// Returns an error if the operation goes beyond the bounds of the BitField or size is larger than 64.
+func (bf *BitField) ExtractUint64FromEnd(bitsFromEnd, size uint64) (uint64, error) {
+	if bf.err != nil {
+		return 0, bf.err
+	}
+	if size > 64 {
+		return 0, fmt.Errorf("%w: got %d", ErrSizeTooLarge, size)
+	}
+	// The comparison is arranged so that the offset cannot underflow.
+	if bitsFromEnd > bf.size || size > bf.size-bitsFromEnd {
+		return 0, fmt.Errorf("%w: %d bits ending %d bits from the end, size %d", ErrOutOfRange, size, bitsFromEnd, bf.size)
+	}
+	return bf.ExtractUint64(bf.size-bitsFromEnd-size, size)
+}
+
+// TestMask reports whether the size bits starting at offset, read in the same bit order as ExtractUint64,
+// equal mask. A mask with bits set above the lowest size bits never matches.
+// Returns an error if the operation goes beyond the bounds of the BitField or size is larger than 64.
+func (bf *BitField) TestMask(offset, mask, size uint64) (bool, error) {
+	v, err := bf.ExtractUint64(offset, size)
+	if err != nil {
+		return false, err
+	}
+	return v == mask, nil
+}
+
+// ToUint64 retrieves the whole BitField as a uint64 value, in the same bit order as ExtractUint64(0, size).
+// Returns an error if the size of the BitField is larger than 64 bits.
+func (bf *BitField) ToUint64() (uint64, error) {
+	return bf.ExtractUint64(0, bf.size)
+}
+
+// InsertBools sets one bit per element of bits, starting at offset, to 1 for true and 0 for false.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) InsertBools(offset uint64, bits []bool) error {
+	if bf.err == nil {
+		bf.err = bf.insertBools(offset, bits)
+	}
+	return bf.err
+}
+
+func (bf *BitField) insertBools(offset uint64, bits []bool) error {
+	if err := bf.checkRange(offset, uint64(len(bits))); err != nil {
+		return err
+	}
+
+	for i, value := range bits {
+		bf.putBit(offset+uint64(i), value)
+	}
+	return nil
+}
+
+// ExtractBools retrieves count bits starting at offset as a slice of booleans, true for 1 and false for 0.
+// Returns an error if the operation goes beyond the bounds of the BitField.
+func (bf *BitField) ExtractBools(offset, count uint64) ([]bool, error) {
+	if bf.err != nil {
+		return nil, bf.err
+	}
+	if err := bf.checkRange(offset, count); err != nil {
+		return nil, err
+	}
+
+	bits := make([]bool, count)
+	for i := range bits {
+		bits[i] = bf.bit(offset + uint64(i))
+	}
+	return bits, nil
+}
@@ -0,0 +1,627 @@
+package bitfield
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type TypedInsertTestCase struct {
+	name         string                   // Name of the test case
+	bf           *BitField                // Initial BitField for the test
+	insert       func(bf *BitField) error // The typed insert to perform
+	expectError  bool                     // Whether an error is expected
+	expectedBits []byte                   // Expected byte slice after inserting
+}
+
+type ExtractAlignedTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // BitField to extract from
+	bytePos       uint64    // Byte at which the field starts
+	bitWidth      uint64    // Number of bits in the field
+	expectedError error     // Expected error, or nil for none
+	expectedValue uint64    // Expected extracted value
+}
+
+type InsertAutoTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // Initial BitField for the test
+	offset        uint64    // Offset at which to insert the value
+	value         uint64    // Value to insert
+	expectError   bool      // Whether an error is expected
+	expectedWidth uint64    // Expected number of bits consumed
+	expectedBits  []byte    // Expected byte slice after inserting
+}
+
+type ExtractFromEndTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // BitField to extract from
+	bitsFromEnd   uint64    // Number of bits between the end of the field and the end of the BitField
+	size          uint64    // Number of bits in the field
+	expectedError error     // Expected error, or nil for none
+	expectedValue uint64    // Expected extracted value
+}
+
+type TestMaskTestCase struct {
+	name          string    // Name of the test case
+	bf            *BitField // BitField to test
+	offset        uint64    // Start of the window
+	mask          uint64    // Value the window is compared with
+	size          uint64    // Number of bits in the window
+	expectError   bool      // Whether an error is expected
+	expectedMatch bool      // Whether the window is expected to equal mask
+}
+
+// Test cases
+
+var typedInsertTestCases = []TypedInsertTestCase{
+	{
+		name:         "InsertUint8 LE",
+		bf:           LittleEndian.New(16),
+		insert:       func(bf *BitField) error { return bf.InsertUint8(4, 0xA5) },
+		expectedBits: []byte{0x50, 0x0A},
+	},
+	{
+		name:         "InsertUint8 BE",
+		bf:           BigEndian.New(16),
+		insert:       func(bf *BitField) error { return bf.InsertUint8(4, 0xA5) },
+		expectedBits: []byte{0x0A, 0x50},
+	},
+	{
+		name:        "InsertUint8 beyond size",
+		bf:          LittleEndian.New(16),
+		insert:      func(bf *BitField) error { return bf.InsertUint8(9, 0xFF) },
+		expectError: true,
+	},
+	{
+		name:         "InsertUint16 LE",
+		bf:           LittleEndian.New(16),
+		insert:       func(bf *BitField) error { return bf.InsertUint16(0, 0x1234) },
+		expectedBits: []byte{0x34, 0x12},
+	},
+	{
+		name:         "InsertUint16 BE",
+		bf:           BigEndian.New(16),
+		insert:       func(bf *BitField) error { return bf.InsertUint16(0, 0x1234) },
+		expectedBits: []byte{0x12, 0x34},
+	},
+	{
+		name:        "InsertUint16 beyond size",
+		bf:          BigEndian.New(16),
+		insert:      func(bf *BitField) error { return bf.InsertUint16(1, 0x1234) },
+		expectError: true,
+	},
+	{
+		name:         "InsertUint32 LE",
+		bf:           LittleEndian.New(32),
+		insert:       func(bf *BitField) error { return bf.InsertUint32(0, 0x12345678) },
+		expectedBits: []byte{0x78, 0x56, 0x34, 0x12},
+	},
+	{
+		name:         "InsertUint32 BE",
+		bf:           BigEndian.New(32),
+		insert:       func(bf *BitField) error { return bf.InsertUint32(0, 0x12345678) },
+		expectedBits: []byte{0x12, 0x34, 0x56, 0x78},
+	},
+	{
+		name:        "InsertUint32 beyond size",
+		bf:          LittleEndian.New(40),
+		insert:      func(bf *BitField) error { return bf.InsertUint32(9, 0x12345678) },
+		expectError: true,
+	},
+}
+
+// headerBytes is a synthetic 9-byte header: an 8-bit type, a 16-bit length, a 32-bit identifier
+// and a 12-bit checksum followed by 4 unused bits.
+var headerBytes = []byte{0x45, 0x12, 0x34, 0xDE, 0xAD, 0xBE, 0xEF, 0xAB, 0xC0}
+
+var extractAlignedTestCases = []ExtractAlignedTestCase{
+	{
+		name:          "Type field BE",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       0,
+		bitWidth:      8,
+		expectedValue: 0x45,
+	},
+	{
+		name:          "Length field BE",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       1,
+		bitWidth:      16,
+		expectedValue: 0x1234,
+	},
+	{
+		name:          "Identifier field BE",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       3,
+		bitWidth:      32,
+		expectedValue: 0xDEADBEEF,
+	},
+	{
+		name:          "Partial byte checksum field BE",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       7,
+		bitWidth:      12,
+		expectedValue: 0xABC,
+	},
+	{
+		name:          "Length field LE",
+		bf:            LittleEndian.FromBytes(headerBytes, 72),
+		bytePos:       1,
+		bitWidth:      16,
+		expectedValue: 0x3412,
+	},
+	{
+		name:          "Partial byte field LE",
+		bf:            LittleEndian.FromBytes(headerBytes, 72),
+		bytePos:       7,
+		bitWidth:      12,
+		expectedValue: 0x0AB,
+	},
+	{
+		name:          "Zero width at end",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       9,
+		bitWidth:      0,
+		expectedValue: 0,
+	},
+	{
+		name:          "Width beyond end",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       7,
+		bitWidth:      17,
+		expectedError: ErrOutOfRange,
+	},
+	{
+		name:          "Byte beyond end",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       10,
+		bitWidth:      0,
+		expectedError: ErrOutOfRange,
+	},
+	{
+		name:          "Byte position overflow",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       math.MaxUint64/8 + 1, // Wraps to offset 0 when multiplied by 8
+		bitWidth:      8,
+		expectedError: ErrOutOfRange,
+	},
+	{
+		name:          "Width larger than 64",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		bytePos:       0,
+		bitWidth:      65,
+		expectedError: ErrSizeTooLarge,
+	},
+	{
+		name: "Previous error",
+		bf: &BitField{
+			data:        []byte{0xFF},
+			size:        8,
+			manipulator: BigEndian,
+			msb0:        true,
+			err:         ErrInvalidValue,
+		},
+		bytePos:       0,
+		bitWidth:      8,
+		expectedError: ErrInvalidValue,
+	},
+}
+
+var insertAutoTestCases = []InsertAutoTestCase{
+	{
+		name:          "Zero value consumes no bits",
+		bf:            LittleEndian.New(8),
+		offset:        3,
+		value:         0,
+		expectedWidth: 0,
+		expectedBits:  []byte{0b00000000},
+	},
+	{
+		name:          "Zero value at end",
+		bf:            BigEndian.New(8),
+		offset:        8,
+		value:         0,
+		expectedWidth: 0,
+		expectedBits:  []byte{0b00000000},
+	},
+	{
+		name:          "Single bit LE",
+		bf:            LittleEndian.New(8),
+		offset:        2,
+		value:         1,
+		expectedWidth: 1,
+		expectedBits:  []byte{0b00000100},
+	},
+	{
+		name:          "Five bits LE",
+		bf:            LittleEndian.New(16),
+		offset:        6,
+		value:         0b10110,
+		expectedWidth: 5,
+		expectedBits:  []byte{0b10000000, 0b00000101},
+	},
+	{
+		name:          "Five bits BE",
+		bf:            BigEndian.New(16),
+		offset:        6,
+		value:         0b10110,
+		expectedWidth: 5,
+		expectedBits:  []byte{0b00000010, 0b11000000},
+	},
+	{
+		name:          "Full 64 bits",
+		bf:            BigEndian.New(64),
+		offset:        0,
+		value:         0x8000000000000001,
+		expectedWidth: 64,
+		expectedBits:  []byte{0x80, 0, 0, 0, 0, 0, 0, 0x01},
+	},
+	{
+		name:         "Beyond size",
+		bf:           LittleEndian.New(8),
+		offset:       5,
+		value:        0b1000,
+		expectError:  true,
+		expectedBits: []byte{0b00000000},
+	},
+}
+
+var extractFromEndTestCases = []ExtractFromEndTestCase{
+	{
+		name:          "Last 4 bits BE",
+		bf:            BigEndian.FromBytes([]byte{0x12, 0x34, 0x50}, 20),
+		bitsFromEnd:   0,
+		size:          4,
+		expectedValue: 0x5,
+	},
+	{
+		name:          "Last 4 bits LE",
+		bf:            LittleEndian.FromBytes([]byte{0x34, 0x12, 0x05}, 20),
+		bitsFromEnd:   0,
+		size:          4,
+		expectedValue: 0x5,
+	},
+	{
+		name:          "Field ending 3 bits from the end BE",
+		bf:            BigEndian.FromBytes([]byte{0b00000101, 0b10000000}, 12),
+		bitsFromEnd:   3,
+		size:          4,
+		expectedValue: 0b1011, // Positions 5 to 8
+	},
+	{
+		name:          "Field ending 3 bits from the end LE",
+		bf:            LittleEndian.FromBytes([]byte{0b01100000, 0b00000001}, 12),
+		bitsFromEnd:   3,
+		size:          4,
+		expectedValue: 0b1011, // Positions 5 to 8
+	},
+	{
+		name:          "Whole BitField",
+		bf:            BigEndian.FromBytes([]byte{0xAB, 0xC0}, 12),
+		bitsFromEnd:   0,
+		size:          12,
+		expectedValue: 0xABC,
+	},
+	{
+		name:          "Zero size at the start",
+		bf:            BigEndian.New(12),
+		bitsFromEnd:   12,
+		size:          0,
+		expectedValue: 0,
+	},
+	{
+		name:          "Field reaching before the start",
+		bf:            BigEndian.New(12),
+		bitsFromEnd:   3,
+		size:          10,
+		expectedError: ErrOutOfRange,
+	},
+	{
+		name:          "Distance beyond the size",
+		bf:            LittleEndian.New(12),
+		bitsFromEnd:   13,
+		size:          0,
+		expectedError: ErrOutOfRange,
+	},
+	{
+		name:          "Distance that would wrap around",
+		bf:            LittleEndian.New(12),
+		bitsFromEnd:   math.MaxUint64,
+		size:          8,
+		expectedError: ErrOutOfRange,
+	},
+	{
+		name:          "Size larger than 64",
+		bf:            LittleEndian.New(128),
+		bitsFromEnd:   0,
+		size:          65,
+		expectedError: ErrSizeTooLarge,
+	},
+}
+
+var testMaskTestCases = []TestMaskTestCase{
+	{
+		name:          "Matching window BE",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		offset:        4,
+		mask:          0x51,
+		size:          8,
+		expectedMatch: true,
+	},
+	{
+		name:          "Non-matching window BE",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		offset:        4,
+		mask:          0x52,
+		size:          8,
+		expectedMatch: false,
+	},
+	{
+		name:          "Matching window LE",
+		bf:            LittleEndian.FromBytes(headerBytes, 72),
+		offset:        4,
+		mask:          0x24,
+		size:          8,
+		expectedMatch: true,
+	},
+	{
+		name:          "Non-matching window LE",
+		bf:            LittleEndian.FromBytes(headerBytes, 72),
+		offset:        4,
+		mask:          0x51,
+		size:          8,
+		expectedMatch: false,
+	},
+	{
+		name:          "Matching 64-bit window",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		offset:        8,
+		mask:          0x1234DEADBEEFABC0,
+		size:          64,
+		expectedMatch: true,
+	},
+	{
+		name:          "Mask wider than the window",
+		bf:            BigEndian.FromBytes(headerBytes, 72),
+		offset:        0,
+		mask:          0x145,
+		size:          8,
+		expectedMatch: false,
+	},
+	{
+		name:        "Window beyond size",
+		bf:          LittleEndian.FromBytes(headerBytes, 72),
+		offset:      68,
+		mask:        0,
+		size:        8,
+		expectError: true,
+	},
+}
+
+// Test functions
+
+func TestTypedInsert(t *testing.T) {
+	for _, tc := range typedInsertTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.insert(tc.bf)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("%s returned unexpected error: got %v, want %v", tc.name, err, tc.expectError)
+				return
+			}
+
+			if !tc.expectError && !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("%s got %v, want %v", tc.name, tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestTypedRoundTrip(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(64)
+		bf.InsertUint8(3, 0xC3)
+		bf.InsertUint16(11, 0xBEEF)
+		bf.InsertUint32(27, 0xDEADBEEF)
+		if err := bf.Error(); err != nil {
+			t.Errorf("typed insert returned unexpected error: %v", err)
+			continue
+		}
+
+		if v, err := bf.ExtractUint8(3); err != nil || v != 0xC3 {
+			t.Errorf("ExtractUint8() got (%#x, %v), want (0xc3, nil)", v, err)
+		}
+		if v, err := bf.ExtractUint16(11); err != nil || v != 0xBEEF {
+			t.Errorf("ExtractUint16() got (%#x, %v), want (0xbeef, nil)", v, err)
+		}
+		if v, err := bf.ExtractUint32(27); err != nil || v != 0xDEADBEEF {
+			t.Errorf("ExtractUint32() got (%#x, %v), want (0xdeadbeef, nil)", v, err)
+		}
+	}
+}
+
+func TestTypedExtractOutOfRange(t *testing.T) {
+	bf := BigEndian.New(31)
+	if _, err := bf.ExtractUint8(24); err == nil {
+		t.Errorf("ExtractUint8() beyond size expected an error, but got none")
+	}
+	if _, err := bf.ExtractUint16(16); err == nil {
+		t.Errorf("ExtractUint16() beyond size expected an error, but got none")
+	}
+	if _, err := bf.ExtractUint32(0); err == nil {
+		t.Errorf("ExtractUint32() beyond size expected an error, but got none")
+	}
+}
+
+func TestInsertBools(t *testing.T) {
+	values := []bool{true, false, true, true, false, false, true, false, true, true}
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(16)
+		if err := bf.InsertBools(3, values); err != nil {
+			t.Errorf("InsertBools() returned unexpected error: %v", err)
+			continue
+		}
+
+		for pos := uint64(0); pos < bf.size; pos++ {
+			expected := pos >= 3 && pos < 13 && values[pos-3]
+			if bit, _ := bf.TestBit(pos); bit != expected {
+				t.Errorf("TestBit(%d) got %t, want %t", pos, bit, expected)
+			}
+		}
+
+		if err := m.New(12).InsertBools(3, values); err == nil {
+			t.Errorf("InsertBools() beyond size expected an error, but got none")
+		}
+	}
+}
+
+func TestExtractBools(t *testing.T) {
+	values := []bool{true, true, false, true, false, false, false, true, false, true, true}
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(24)
+		bf.InsertBools(6, values)
+
+		extracted, err := bf.ExtractBools(6, uint64(len(values)))
+		if err != nil {
+			t.Errorf("ExtractBools() returned unexpected error: %v", err)
+			continue
+		}
+		if !reflect.DeepEqual(extracted, values) {
+			t.Errorf("ExtractBools() got %v, want %v", extracted, values)
+		}
+
+		if _, err := bf.ExtractBools(20, 5); err == nil {
+			t.Errorf("ExtractBools() beyond size expected an error, but got none")
+		}
+	}
+}
+
+func TestExtractUint64Aligned(t *testing.T) {
+	for _, tc := range extractAlignedTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := tc.bf.ExtractUint64Aligned(tc.bytePos, tc.bitWidth)
+
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("ExtractUint64Aligned() got error %v, want %v", err, tc.expectedError)
+				return
+			}
+
+			if tc.expectedError == nil && value != tc.expectedValue {
+				t.Errorf("ExtractUint64Aligned() got %#x, want %#x", value, tc.expectedValue)
+			}
+		})
+	}
+}
+
+func TestInsertUint64Auto(t *testing.T) {
+	for _, tc := range insertAutoTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			width, err := tc.bf.InsertUint64Auto(tc.offset, tc.value)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("InsertUint64Auto() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if width != tc.expectedWidth {
+				t.Errorf("InsertUint64Auto() consumed %d bits, want %d", width, tc.expectedWidth)
+			}
+			if !reflect.DeepEqual(tc.bf.data, tc.expectedBits) {
+				t.Errorf("InsertUint64Auto() got %v, want %v", tc.bf.data, tc.expectedBits)
+			}
+		})
+	}
+}
+
+func TestInsertUint64AutoRoundTrip(t *testing.T) {
+	values := []uint64{0b1, 0b0, 0b101, 0xFF, 0b11, 0x1234}
+
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.New(64)
+
+		// Pack the values back to back, recording the width consumed by each
+		var offset uint64
+		widths := make([]uint64, len(values))
+		for i, v := range values {
+			width, err := bf.InsertUint64Auto(offset, v)
+			if err != nil {
+				t.Fatalf("InsertUint64Auto(%d, %#x) returned unexpected error: %v", offset, v, err)
+			}
+			widths[i] = width
+			offset += width
+		}
+
+		offset = 0
+		for i, v := range values {
+			got, err := bf.ExtractUint64(offset, widths[i])
+			if err != nil || got != v {
+				t.Errorf("ExtractUint64(%d, %d) got (%#x, %v), want (%#x, nil)", offset, widths[i], got, err, v)
+			}
+			offset += widths[i]
+		}
+	}
+}
+
+func TestToUint64(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		for size := uint64(0); size <= 64; size++ {
+			want := uint64(0xA5C3F00F1E2D3C4B) >> (64 - size) // A value of size bits
+
+			bf := m.New(size)
+			bf.InsertUint64(0, size, want)
+			if v, err := bf.ToUint64(); err != nil || v != want {
+				t.Errorf("ToUint64() of %d bits got (%#x, %v), want (%#x, nil)", size, v, err, want)
+			}
+		}
+
+		if _, err := m.New(65).ToUint64(); !errors.Is(err, ErrSizeTooLarge) {
+			t.Errorf("ToUint64() of 65 bits got error %v, want %v", err, ErrSizeTooLarge)
+		}
+	}
+
+	// The value follows the manipulator's ordering
+	if v, _ := LittleEndian.FromBytes([]byte{0x34, 0x12}, 16).ToUint64(); v != 0x1234 {
+		t.Errorf("ToUint64() LE got %#x, want %#x", v, 0x1234)
+	}
+	if v, _ := BigEndian.FromBytes([]byte{0x12, 0x34}, 16).ToUint64(); v != 0x1234 {
+		t.Errorf("ToUint64() BE got %#x, want %#x", v, 0x1234)
+	}
+}
+
+func TestExtractUint64FromEnd(t *testing.T) {
+	for _, tc := range extractFromEndTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := tc.bf.ExtractUint64FromEnd(tc.bitsFromEnd, tc.size)
+
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("ExtractUint64FromEnd() got error %v, want %v", err, tc.expectedError)
+				return
+			}
+
+			if tc.expectedError == nil && value != tc.expectedValue {
+				t.Errorf("ExtractUint64FromEnd() got %#x, want %#x", value, tc.expectedValue)
+			}
+		})
+	}
+}
+
+func TestTestMask(t *testing.T) {
+	for _, tc := range testMaskTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, err := tc.bf.TestMask(tc.offset, tc.mask, tc.size)
+
+			if (err != nil) != tc.expectError {
+				t.Errorf("TestMask() returned unexpected error: got %v, want %v", err, tc.expectError)
+				return
+			}
+
+			if match != tc.expectedMatch {
+				t.Errorf("TestMask() got %t, want %t", match, tc.expectedMatch)
+			}
+		})
+	}
+}
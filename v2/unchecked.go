@@ -0,0 +1,42 @@
+package bitfield
+
+// bitMasks holds the mask of each in-byte position within its byte,
+// under LSb 0 numbering in the first row and MSb 0 numbering in the second.
+var bitMasks = [2][8]byte{
+	{0x01, 0x02, 0x04, 0x08, 0x10, 0x20, 0x40, 0x80},
+	{0x80, 0x40, 0x20, 0x10, 0x08, 0x04, 0x02, 0x01},
+}
+
+// bitMask returns the mask of the bit holding pos within its byte, looked up from the cached bit order
+// rather than computed through posMask, as it is used on hot paths.
+func (bf *BitField) bitMask(pos uint64) byte {
+	order := 0
+	if bf.msb0 {
+		order = 1
+	}
+	return bitMasks[order][pos%8]
+}
+
+// TestBitUnchecked reports whether the bit at pos is set, without checking that pos is within the size
+// of the BitField and without consulting the sticky error. Validating pos is the caller's responsibility:
+// a position beyond the underlying data panics, and a position within the padding bits returns a meaningless result.
+func (bf *BitField) TestBitUnchecked(pos uint64) bool {
+	return bf.data[pos/8]&bf.bitMask(pos) != 0
+}
+
+// SetBitUnchecked sets the bit at pos to 1, without checking that pos is within the size of the BitField
+// and without consulting or setting the sticky error. Validating pos is the caller's responsibility:
+// a position beyond the underlying data panics, and a position within the padding bits sets a padding bit.
+func (bf *BitField) SetBitUnchecked(pos uint64) {
+	bf.data[pos/8] |= bf.bitMask(pos)
+}
+
+// ClearBitUnchecked sets the bit at pos to 0. The same contract as for SetBitUnchecked applies.
+func (bf *BitField) ClearBitUnchecked(pos uint64) {
+	bf.data[pos/8] &^= bf.bitMask(pos)
+}
+
+// ToggleBitUnchecked inverts the bit at pos. The same contract as for SetBitUnchecked applies.
+func (bf *BitField) ToggleBitUnchecked(pos uint64) {
+	bf.data[pos/8] ^= bf.bitMask(pos)
+}
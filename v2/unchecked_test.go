@@ -0,0 +1,133 @@
+package bitfield
+
+import (
+	"testing"
+)
+
+// Test functions
+
+func TestTestBitUnchecked(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		bf := m.FromBytes([]byte{0b10010110, 0b01110001}, 13)
+
+		for pos := uint64(0); pos < bf.Size(); pos++ {
+			expected, err := bf.TestBit(pos)
+			if err != nil {
+				t.Fatalf("TestBit() returned unexpected error: %v", err)
+			}
+			if got := bf.TestBitUnchecked(pos); got != expected {
+				t.Errorf("TestBitUnchecked(%d) got %t, want %t", pos, got, expected)
+			}
+		}
+	}
+}
+
+func TestTestBitUncheckedPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestBitUnchecked() did not panic beyond the underlying data")
+		}
+	}()
+	LittleEndian.New(8).TestBitUnchecked(8)
+}
+
+func TestUncheckedMutators(t *testing.T) {
+	ops := []struct {
+		name      string
+		checked   func(bf *BitField, pos uint64) error
+		unchecked func(bf *BitField, pos uint64)
+	}{
+		{"SetBitUnchecked", (*BitField).SetBit, (*BitField).SetBitUnchecked},
+		{"ClearBitUnchecked", (*BitField).ClearBit, (*BitField).ClearBitUnchecked},
+		{"ToggleBitUnchecked", (*BitField).ToggleBit, (*BitField).ToggleBitUnchecked},
+	}
+
+	for _, op := range ops {
+		for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+			checked := m.FromBytes([]byte{0b10010110, 0b01110001}, 13)
+			unchecked := checked.Clone()
+
+			for pos := uint64(0); pos < checked.Size(); pos += 3 {
+				if err := op.checked(checked, pos); err != nil {
+					t.Fatalf("%s: checked variant returned unexpected error: %v", op.name, err)
+				}
+				op.unchecked(unchecked, pos)
+			}
+
+			if !unchecked.StrictEqual(checked) {
+				t.Errorf("%s got %v, want %v", op.name, unchecked, checked)
+			}
+		}
+	}
+}
+
+func TestUncheckedMutatorsPanic(t *testing.T) {
+	for _, op := range []func(bf *BitField, pos uint64){
+		(*BitField).SetBitUnchecked,
+		(*BitField).ClearBitUnchecked,
+		(*BitField).ToggleBitUnchecked,
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("unchecked mutator did not panic beyond the underlying data")
+				}
+			}()
+			op(BigEndian.New(16), 16)
+		}()
+	}
+}
+
+func BenchmarkTestBit(b *testing.B) {
+	bf := LittleEndian.New(1 << 16)
+	for i := 0; i < b.N; i++ {
+		for pos := uint64(0); pos < 1<<16; pos += 7 {
+			bf.TestBit(pos)
+		}
+	}
+}
+
+func BenchmarkTestBitUnchecked(b *testing.B) {
+	bf := LittleEndian.New(1 << 16)
+	for i := 0; i < b.N; i++ {
+		for pos := uint64(0); pos < 1<<16; pos += 7 {
+			bf.TestBitUnchecked(pos)
+		}
+	}
+}
+
+func BenchmarkToggleBit(b *testing.B) {
+	bf := LittleEndian.New(1 << 16)
+	for i := 0; i < b.N; i++ {
+		for pos := uint64(0); pos < 1<<16; pos += 7 {
+			bf.ToggleBit(pos)
+		}
+	}
+}
+
+func BenchmarkToggleBitUnchecked(b *testing.B) {
+	bf := LittleEndian.New(1 << 16)
+	for i := 0; i < b.N; i++ {
+		for pos := uint64(0); pos < 1<<16; pos += 7 {
+			bf.ToggleBitUnchecked(pos)
+		}
+	}
+}
+
+func BenchmarkToggleBitBE(b *testing.B) {
+	bf := BigEndian.New(1 << 16)
+	for i := 0; i < b.N; i++ {
+		for pos := uint64(0); pos < 1<<16; pos += 7 {
+			bf.ToggleBit(pos)
+		}
+	}
+}
+
+func BenchmarkToggleBitUncheckedBE(b *testing.B) {
+	bf := BigEndian.New(1 << 16)
+	for i := 0; i < b.N; i++ {
+		for pos := uint64(0); pos < 1<<16; pos += 7 {
+			bf.ToggleBitUnchecked(pos)
+		}
+	}
+}
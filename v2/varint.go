@@ -0,0 +1,48 @@
+package bitfield
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteUvarintBits appends v encoded as a sequence of 8-bit groups, least significant group first.
+// Each group holds 7 bits of v below a continuation bit that is set on every group except the last,
+// and is written in the same bit order as WriteBits. Values below 128 take 8 bits, and any uint64 at most 80.
+func (w *BitWriter) WriteUvarintBits(v uint64) error {
+	for v >= 0x80 {
+		if err := w.WriteBits(v&0x7F|0x80, 8); err != nil {
+			return err
+		}
+		v >>= 7
+	}
+	return w.WriteBits(v, 8)
+}
+
+// ReadUvarintBits reads a value encoded by WriteUvarintBits and advances past it.
+// Returns io.EOF if no complete group remains, io.ErrUnexpectedEOF if the encoding is cut short and
+// an error if it overflows a uint64. The reader does not advance on error.
+func (r *BitReader) ReadUvarintBits() (uint64, error) {
+	start := r.pos
+
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		group, err := r.ReadBits(8)
+		if err != nil {
+			r.pos = start
+			if err == io.EOF && shift > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+
+		// The tenth group holds only the most significant bit of a uint64
+		if shift == 63 && group > 1 {
+			r.pos = start
+			return 0, fmt.Errorf("%w: varint overflows 64 bits", ErrInvalidEncoding)
+		}
+		v |= (group & 0x7F) << shift
+		if group < 0x80 {
+			return v, nil
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package bitfield
+
+import (
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type UvarintBitsTestCase struct {
+	value        uint64 // The value to encode
+	expectedBits uint64 // Expected number of bits used by the encoding
+}
+
+// Test cases
+
+var uvarintBitsTestCases = []UvarintBitsTestCase{
+	{value: 0, expectedBits: 8},
+	{value: 1, expectedBits: 8},
+	{value: 127, expectedBits: 8},
+	{value: 128, expectedBits: 16},
+	{value: 300, expectedBits: 16},
+	{value: 16383, expectedBits: 16},
+	{value: 16384, expectedBits: 24},
+	{value: math.MaxUint32, expectedBits: 40},
+	{value: 1 << 63, expectedBits: 80},
+	{value: math.MaxUint64, expectedBits: 80},
+}
+
+// Test functions
+
+func TestUvarintBitsRoundTrip(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		w := NewBitWriter(m.New(0))
+		w.WriteBits(0b101, 3) // Leaves the varints unaligned
+
+		for _, tc := range uvarintBitsTestCases {
+			before := w.Bits()
+			if err := w.WriteUvarintBits(tc.value); err != nil {
+				t.Fatalf("WriteUvarintBits(%d) returned unexpected error: %v", tc.value, err)
+			}
+			if got := w.Bits() - before; got != tc.expectedBits {
+				t.Errorf("WriteUvarintBits(%d) wrote %d bits, want %d", tc.value, got, tc.expectedBits)
+			}
+		}
+
+		r := NewBitReader(w.BitField())
+		r.ReadBits(3)
+		for _, tc := range uvarintBitsTestCases {
+			if v, err := r.ReadUvarintBits(); err != nil || v != tc.value {
+				t.Errorf("ReadUvarintBits() got (%d, %v), want (%d, nil)", v, err, tc.value)
+			}
+		}
+
+		if _, err := r.ReadUvarintBits(); err != io.EOF {
+			t.Errorf("ReadUvarintBits() at end got error %v, want %v", err, io.EOF)
+		}
+	}
+}
+
+func TestUvarintBitsEncoding(t *testing.T) {
+	w := NewBitWriter(BigEndian.New(0))
+	w.WriteUvarintBits(300) // 0b10_0101100
+
+	if expected := []byte{0b10101100, 0b00000010}; !reflect.DeepEqual(w.BitField().data, expected) {
+		t.Errorf("WriteUvarintBits(300) got %08b, want %08b", w.BitField().data, expected)
+	}
+}
+
+func TestReadUvarintBitsTruncated(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		w := NewBitWriter(m.New(0))
+		w.WriteUvarintBits(1 << 20)
+
+		// Drop the final group, leaving a continuation bit with nothing after it
+		bf := w.BitField()
+		bf.Resize(bf.Size() - 8)
+
+		r := NewBitReader(bf)
+		if _, err := r.ReadUvarintBits(); err != io.ErrUnexpectedEOF {
+			t.Errorf("ReadUvarintBits() got error %v, want %v", err, io.ErrUnexpectedEOF)
+		}
+		if r.Remaining() != bf.Size() {
+			t.Errorf("ReadUvarintBits() advanced on error: got %d remaining, want %d", r.Remaining(), bf.Size())
+		}
+	}
+}
+
+func TestReadUvarintBitsOverflow(t *testing.T) {
+	for _, data := range [][]byte{
+		{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x02},       // Tenth group holds more than 1 bit
+		{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x81, 0x00}, // More than ten groups
+	} {
+		r := NewBitReader(LittleEndian.FromBytes(data, uint64(len(data))*8))
+		if _, err := r.ReadUvarintBits(); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("ReadUvarintBits(%x) got error %v, want %v", data, err, ErrInvalidEncoding)
+		}
+		if r.Remaining() != uint64(len(data))*8 {
+			t.Errorf("ReadUvarintBits(%x) advanced on error", data)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package bitfield
+
+import (
+	"fmt"
+)
+
+// BitWriter appends bits to the end of a BitField, growing it as needed.
+type BitWriter struct {
+	bf *BitField // The BitField being written to.
+}
+
+// NewBitWriter returns a BitWriter that appends to bf, starting after its current last bit.
+// The bits are written using the manipulator of bf.
+func NewBitWriter(bf *BitField) *BitWriter {
+	return &BitWriter{bf: bf}
+}
+
+// WriteBits appends the lowest count bits of value, in the same bit order as InsertUint64.
+// Returns an error if count is greater than 64 or if the BitField has a sticky error.
+func (w *BitWriter) WriteBits(value uint64, count uint64) error {
+	if count > 64 {
+		return fmt.Errorf("%w: got %d", ErrSizeTooLarge, count)
+	}
+
+	offset := w.bf.size
+	if err := w.bf.Resize(offset + count); err != nil {
+		return err
+	}
+	return w.bf.InsertUint64(offset, count, value)
+}
+
+// Bits returns the number of bits written so far, including any bits the BitField held initially.
+func (w *BitWriter) Bits() uint64 {
+	return w.bf.size
+}
+
+// BitField returns the BitField being written to. Subsequent writes continue to modify it.
+func (w *BitWriter) BitField() *BitField {
+	return w.bf
+}
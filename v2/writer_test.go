@@ -0,0 +1,74 @@
+package bitfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test case structs
+
+type WriteBitsTestCase struct {
+	value uint64 // The value to write
+	count uint64 // The number of bits to write
+}
+
+// Test cases
+
+var writeBitsTestCases = []WriteBitsTestCase{
+	{value: 0b101, count: 3},
+	{value: 0b1, count: 1},
+	{value: 0xBEEF, count: 16},
+	{value: 0, count: 0},
+	{value: 0b11011, count: 5},
+	{value: 0x0123456789ABCDEF, count: 64},
+	{value: 0b10, count: 2},
+}
+
+// Test functions
+
+func TestBitWriter(t *testing.T) {
+	for _, m := range []BitManipulator{LittleEndian, BigEndian} {
+		w := NewBitWriter(m.New(0))
+		for _, tc := range writeBitsTestCases {
+			if err := w.WriteBits(tc.value, tc.count); err != nil {
+				t.Errorf("WriteBits() returned unexpected error: %v", err)
+			}
+		}
+
+		if w.Bits() != 91 {
+			t.Errorf("Bits() got %d, want %d", w.Bits(), 91)
+		}
+
+		bf := w.BitField()
+		if bf.manipulator != m {
+			t.Errorf("BitField() got manipulator %v, want %v", bf.manipulator, m)
+		}
+
+		var offset uint64
+		for _, tc := range writeBitsTestCases {
+			if value, _ := bf.ExtractUint64(offset, tc.count); value != tc.value {
+				t.Errorf("ExtractUint64(%d, %d) got %#x, want %#x", offset, tc.count, value, tc.value)
+			}
+			offset += tc.count
+		}
+	}
+}
+
+func TestBitWriterAppendsToExistingField(t *testing.T) {
+	w := NewBitWriter(BigEndian.FromBytes([]byte{0b11000000}, 2))
+	w.WriteBits(0b0110, 4)
+
+	if expected := []byte{0b11011000}; !reflect.DeepEqual(w.BitField().data, expected) {
+		t.Errorf("WriteBits() got %v, want %v", w.BitField().data, expected)
+	}
+}
+
+func TestBitWriterInvalidCount(t *testing.T) {
+	w := NewBitWriter(LittleEndian.New(0))
+	if err := w.WriteBits(0, 65); err == nil {
+		t.Errorf("WriteBits() with count 65 expected an error, but got none")
+	}
+	if w.Bits() != 0 {
+		t.Errorf("Bits() got %d, want %d", w.Bits(), 0)
+	}
+}